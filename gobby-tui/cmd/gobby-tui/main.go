@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 
+	"gobby-tui/internal/adapter"
 	"gobby-tui/internal/client"
 	"gobby-tui/internal/tui"
 
@@ -12,7 +13,8 @@ import (
 
 func main() {
 	c := client.NewGobbyClient()
-	p := tea.NewProgram(tui.NewMainModel(c), tea.WithAltScreen())
+	systems := []adapter.TaskSystem{adapter.NewGobbyAdapter(c)}
+	p := tea.NewProgram(tui.NewMainModel(c, systems), tea.WithAltScreen())
 	if _, err := p.Run(); err != nil {
 		fmt.Printf("Alas, there's been an error: %v", err)
 		os.Exit(1)
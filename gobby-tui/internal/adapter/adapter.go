@@ -0,0 +1,76 @@
+// Package adapter lets Gobby target more than one task-tracking backend
+// behind a single TaskSystem interface, so panes can work with GitHub
+// Issues, Linear, or Jira the same way they work with Gobby's own daemon.
+package adapter
+
+import "context"
+
+// Capability flags let a pane hide actions a backend doesn't support, e.g.
+// no kanban columns for a system without a notion of status.
+type Capability uint8
+
+const (
+	CapTasks Capability = 1 << iota
+	CapKanbanStatuses
+	CapChat
+	CapProjects
+)
+
+// Has reports whether c includes every flag set in want.
+func (c Capability) Has(want Capability) bool {
+	return c&want == want
+}
+
+// Task is a backend-agnostic task, tagged with the SystemID of the
+// TaskSystem it came from so mutations can be routed back to it.
+type Task struct {
+	ID          string
+	Title       string
+	Status      string
+	Priority    int
+	SeqNum      int
+	Description string
+	ParentID    string
+	SystemID    string
+}
+
+// Project is a backend-agnostic project, likewise tagged with SystemID.
+type Project struct {
+	ID       string
+	Name     string
+	SystemID string
+}
+
+// ChatEventType categorizes the events emitted while an agent turn streams
+// in over TaskSystem.ChatStream.
+type ChatEventType string
+
+const (
+	ChatEventToken      ChatEventType = "token"
+	ChatEventToolCall   ChatEventType = "tool_call"
+	ChatEventToolResult ChatEventType = "tool_result"
+	ChatEventDone       ChatEventType = "done"
+	ChatEventError      ChatEventType = "error"
+)
+
+// ChatEvent is one backend-agnostic chat-stream event, mirroring the
+// system-specific event types (e.g. client.ChatEvent) the way Task mirrors
+// client.Task.
+type ChatEvent struct {
+	Type ChatEventType
+	Data string
+}
+
+// TaskSystem is implemented by each backend Gobby can target. SystemID
+// identifies the backend (e.g. "gobby", "github") so callers can route a
+// mutation back to the system that owns the task.
+type TaskSystem interface {
+	SystemID() string
+	Capabilities() Capability
+
+	ListTasks(ctx context.Context, status string) ([]Task, error)
+	UpdateTask(ctx context.Context, id, status string) error
+	DeleteTask(ctx context.Context, id string) error
+	ListProjects(ctx context.Context) ([]Project, error)
+	ChatStream(ctx context.Context, prompt, parentSessionID string, allowedTools []string) (<-chan ChatEvent, func(), error)
+}
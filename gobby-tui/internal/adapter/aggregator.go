@@ -0,0 +1,121 @@
+package adapter
+
+import (
+	"context"
+	"fmt"
+
+	"golang.org/x/sync/errgroup"
+)
+
+// Aggregator fans calls out across every registered TaskSystem and merges
+// the results, tagging each Task/Project with the system it came from.
+// Results are concatenated in registration order rather than re-sorted, so
+// the merged order is stable across calls even though the fan-out itself
+// runs concurrently.
+type Aggregator struct {
+	systems []TaskSystem
+}
+
+// NewAggregator builds an Aggregator over the given systems. There is no
+// default system: every routed call (UpdateTask, DeleteTask, ChatStream)
+// names its target by systemID.
+func NewAggregator(systems ...TaskSystem) *Aggregator {
+	return &Aggregator{systems: systems}
+}
+
+// Systems returns every registered TaskSystem.
+func (a *Aggregator) Systems() []TaskSystem {
+	return a.systems
+}
+
+// System looks up a registered TaskSystem by its SystemID.
+func (a *Aggregator) System(systemID string) (TaskSystem, error) {
+	for _, sys := range a.systems {
+		if sys.SystemID() == systemID {
+			return sys, nil
+		}
+	}
+	return nil, fmt.Errorf("adapter: unknown system %q", systemID)
+}
+
+// ListTasks fans out to every system concurrently and merges the results,
+// preserving registration order.
+func (a *Aggregator) ListTasks(ctx context.Context, status string) ([]Task, error) {
+	perSystem := make([][]Task, len(a.systems))
+	g, ctx := errgroup.WithContext(ctx)
+	for i, sys := range a.systems {
+		i, sys := i, sys
+		g.Go(func() error {
+			tasks, err := sys.ListTasks(ctx, status)
+			if err != nil {
+				return fmt.Errorf("%s: %w", sys.SystemID(), err)
+			}
+			perSystem[i] = tasks
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	var merged []Task
+	for _, tasks := range perSystem {
+		merged = append(merged, tasks...)
+	}
+	return merged, nil
+}
+
+// ListProjects fans out to every system concurrently and merges the
+// results, preserving registration order.
+func (a *Aggregator) ListProjects(ctx context.Context) ([]Project, error) {
+	perSystem := make([][]Project, len(a.systems))
+	g, ctx := errgroup.WithContext(ctx)
+	for i, sys := range a.systems {
+		i, sys := i, sys
+		g.Go(func() error {
+			projects, err := sys.ListProjects(ctx)
+			if err != nil {
+				return fmt.Errorf("%s: %w", sys.SystemID(), err)
+			}
+			perSystem[i] = projects
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	var merged []Project
+	for _, projects := range perSystem {
+		merged = append(merged, projects...)
+	}
+	return merged, nil
+}
+
+// UpdateTask routes the mutation to the system identified by systemID.
+func (a *Aggregator) UpdateTask(ctx context.Context, systemID, id, status string) error {
+	sys, err := a.System(systemID)
+	if err != nil {
+		return err
+	}
+	return sys.UpdateTask(ctx, id, status)
+}
+
+// DeleteTask routes the mutation to the system identified by systemID.
+func (a *Aggregator) DeleteTask(ctx context.Context, systemID, id string) error {
+	sys, err := a.System(systemID)
+	if err != nil {
+		return err
+	}
+	return sys.DeleteTask(ctx, id)
+}
+
+// ChatStream routes the prompt to the system identified by systemID and
+// streams back its reply.
+func (a *Aggregator) ChatStream(ctx context.Context, systemID, prompt, parentSessionID string, allowedTools []string) (<-chan ChatEvent, func(), error) {
+	sys, err := a.System(systemID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return sys.ChatStream(ctx, prompt, parentSessionID, allowedTools)
+}
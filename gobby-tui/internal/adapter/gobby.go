@@ -0,0 +1,88 @@
+package adapter
+
+import (
+	"context"
+
+	"gobby-tui/internal/client"
+)
+
+// GobbySystemID is the SystemID used for the built-in Gobby daemon backend.
+const GobbySystemID = "gobby"
+
+// GobbyAdapter wraps a *client.GobbyClient so it satisfies TaskSystem,
+// tagging every Task/Project it returns with GobbySystemID.
+type GobbyAdapter struct {
+	client *client.GobbyClient
+}
+
+// NewGobbyAdapter adapts an existing GobbyClient for use behind the
+// TaskSystem interface.
+func NewGobbyAdapter(c *client.GobbyClient) *GobbyAdapter {
+	return &GobbyAdapter{client: c}
+}
+
+func (a *GobbyAdapter) SystemID() string { return GobbySystemID }
+
+func (a *GobbyAdapter) Capabilities() Capability {
+	return CapTasks | CapKanbanStatuses | CapChat | CapProjects
+}
+
+func (a *GobbyAdapter) ListTasks(ctx context.Context, status string) ([]Task, error) {
+	tasks, err := a.client.ListTasks(ctx, status)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]Task, len(tasks))
+	for i, t := range tasks {
+		out[i] = Task{
+			ID:          t.ID,
+			Title:       t.Title,
+			Status:      t.Status,
+			Priority:    t.Priority,
+			SeqNum:      t.SeqNum,
+			Description: t.Description,
+			ParentID:    t.ParentID,
+			SystemID:    GobbySystemID,
+		}
+	}
+	return out, nil
+}
+
+func (a *GobbyAdapter) UpdateTask(ctx context.Context, id, status string) error {
+	return a.client.UpdateTask(ctx, id, status)
+}
+
+func (a *GobbyAdapter) DeleteTask(ctx context.Context, id string) error {
+	return a.client.DeleteTask(ctx, id)
+}
+
+func (a *GobbyAdapter) ListProjects(ctx context.Context) ([]Project, error) {
+	projects, err := a.client.ListProjects(ctx)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]Project, len(projects))
+	for i, p := range projects {
+		out[i] = Project{ID: p.ID, Name: p.Name, SystemID: GobbySystemID}
+	}
+	return out, nil
+}
+
+// ChatStream relays the client's SSE chat events onto a channel of the
+// backend-agnostic ChatEvent type, closing it once the client's channel
+// closes.
+func (a *GobbyAdapter) ChatStream(ctx context.Context, prompt, parentSessionID string, allowedTools []string) (<-chan ChatEvent, func(), error) {
+	clientEvents, cancel, err := a.client.ChatStream(ctx, prompt, parentSessionID, allowedTools)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	events := make(chan ChatEvent)
+	go func() {
+		defer close(events)
+		for ev := range clientEvents {
+			events <- ChatEvent{Type: ChatEventType(ev.Type), Data: ev.Data}
+		}
+	}()
+	return events, cancel, nil
+}
@@ -2,12 +2,12 @@ package client
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"os"
-	"time"
 )
 
 const (
@@ -15,10 +15,14 @@ const (
 )
 
 type GobbyClient struct {
-	HTTPClient  *http.Client
-	db          *DBClient
-	ProjectID   string
-	ProjectName string
+	HTTPClient    *http.Client
+	Config        Config
+	db            *DBClient
+	Conversations *ConversationStore
+	watcher       *Watcher
+	ProjectID     string
+	ProjectName   string
+	ProjectPath   string
 }
 
 func NewGobbyClient() *GobbyClient {
@@ -27,20 +31,36 @@ func NewGobbyClient() *GobbyClient {
 		fmt.Printf("Warning: Failed to connect to DB: %v\n", err)
 	}
 
+	convos, err := NewConversationStore()
+	if err != nil {
+		fmt.Printf("Warning: Failed to open conversation store: %v\n", err)
+	}
+
+	cfg := LoadConfig()
+
 	// Attempt to resolve project from CWD
-	var projectID, projectName string
+	var projectID, projectName, projectPath string
 	if db != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), cfg.ListTimeout)
 		cwd, _ := os.Getwd()
-		projectID, projectName, _ = db.ResolveProjectID(cwd)
+		projectID, projectName, _ = db.ResolveProjectID(ctx, cwd)
+		if projectID != "" {
+			projectPath, _ = db.ProjectRepoPath(ctx, projectID)
+		}
+		cancel()
 	}
 
 	return &GobbyClient{
-		HTTPClient: &http.Client{
-			Timeout: 10 * time.Second,
-		},
-		db:          db,
-		ProjectID:   projectID,
-		ProjectName: projectName,
+		// No client-wide Timeout: per-operation deadlines come from Config
+		// via the context each caller builds and passes to CallTool,
+		// ListTools, etc.
+		HTTPClient:    &http.Client{},
+		Config:        cfg,
+		db:            db,
+		Conversations: convos,
+		ProjectID:     projectID,
+		ProjectName:   projectName,
+		ProjectPath:   projectPath,
 	}
 }
 
@@ -48,16 +68,47 @@ func (c *GobbyClient) Close() {
 	if c.db != nil {
 		c.db.Close()
 	}
+	if c.Conversations != nil {
+		c.Conversations.Close()
+	}
+	if c.watcher != nil {
+		c.watcher.Close()
+	}
 }
 
-// SetProject updates the current context project
-func (c *GobbyClient) SetProject(id, name string) {
+// SetProject updates the current context project. It re-points any running
+// filesystem watcher at the new project's repo_path.
+func (c *GobbyClient) SetProject(ctx context.Context, id, name string) {
 	c.ProjectID = id
 	c.ProjectName = name
+	c.ProjectPath = ""
+	if c.db != nil {
+		c.ProjectPath, _ = c.db.ProjectRepoPath(ctx, id)
+	}
+	if c.watcher != nil {
+		c.watcher.Close()
+		c.watcher = nil
+	}
+	c.Watcher()
+}
+
+// Watcher lazily starts (or returns the already-running) filesystem watcher
+// for the hub database and the current project's repo_path.
+func (c *GobbyClient) Watcher() *Watcher {
+	if c.watcher != nil {
+		return c.watcher
+	}
+	w, err := NewWatcher(c.ProjectPath)
+	if err != nil {
+		fmt.Printf("Warning: Failed to start file watcher: %v\n", err)
+		return nil
+	}
+	c.watcher = w
+	return w
 }
 
 // Generic MCP Tool Call
-func (c *GobbyClient) CallTool(server, tool string, args map[string]interface{}) (map[string]interface{}, error) {
+func (c *GobbyClient) CallTool(ctx context.Context, server, tool string, args map[string]interface{}) (map[string]interface{}, error) {
 	payload := map[string]interface{}{
 		"server_name": server,
 		"tool_name":   tool,
@@ -69,7 +120,13 @@ func (c *GobbyClient) CallTool(server, tool string, args map[string]interface{})
 		return nil, fmt.Errorf("failed to marshal args: %w", err)
 	}
 
-	resp, err := c.HTTPClient.Post(DaemonBaseURL+"/mcp/tools/call", "application/json", bytes.NewBuffer(jsonData))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, DaemonBaseURL+"/mcp/tools/call", bytes.NewBuffer(jsonData))
+	if err != nil {
+		return nil, fmt.Errorf("failed to build request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.HTTPClient.Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("daemon request failed: %w", err)
 	}
@@ -94,3 +151,41 @@ func (c *GobbyClient) CallTool(server, tool string, args map[string]interface{})
 	}
 	return result, nil
 }
+
+// ToolInfo describes an MCP tool as advertised by the daemon, including its
+// JSON schema so callers can build forms or validate arguments without
+// hard-coding the tool's argument shape.
+type ToolInfo struct {
+	Server      string          `json:"server_name"`
+	Name        string          `json:"tool_name"`
+	Description string          `json:"description"`
+	Schema      json.RawMessage `json:"schema"`
+}
+
+// ListTools discovers the tools currently exposed by the daemon across all
+// connected MCP servers.
+func (c *GobbyClient) ListTools(ctx context.Context) ([]ToolInfo, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, DaemonBaseURL+"/mcp/tools/list", nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build request: %w", err)
+	}
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("daemon request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("daemon error (%d): %s", resp.StatusCode, string(body))
+	}
+
+	var result struct {
+		Tools []ToolInfo `json:"tools"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+	return result.Tools, nil
+}
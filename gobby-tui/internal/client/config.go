@@ -0,0 +1,74 @@
+package client
+
+import (
+	"os"
+	"path/filepath"
+	"time"
+
+	"gopkg.in/yaml.v2"
+)
+
+// duration unmarshals YAML duration strings ("5m", "5s") into a
+// time.Duration; yaml.v2 has no built-in support for time.Duration.
+type duration time.Duration
+
+func (d *duration) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	parsed, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	*d = duration(parsed)
+	return nil
+}
+
+// Config holds the per-operation deadlines applied to GobbyClient calls. It
+// is loaded from ~/.gobby/gobby-tui.yaml; any field left unset in that file
+// keeps its DefaultConfig value.
+type Config struct {
+	ChatTimeout time.Duration
+	ListTimeout time.Duration
+}
+
+// DefaultConfig returns the timeouts used when no config file is present.
+func DefaultConfig() Config {
+	return Config{
+		ChatTimeout: 5 * time.Minute,
+		ListTimeout: 5 * time.Second,
+	}
+}
+
+type configFile struct {
+	ChatTimeout duration `yaml:"chat_timeout"`
+	ListTimeout duration `yaml:"list_timeout"`
+}
+
+// LoadConfig reads ~/.gobby/gobby-tui.yaml, falling back to DefaultConfig
+// for any field that's absent or if the file itself doesn't exist.
+func LoadConfig() Config {
+	cfg := DefaultConfig()
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return cfg
+	}
+	data, err := os.ReadFile(filepath.Join(home, ".gobby", "gobby-tui.yaml"))
+	if err != nil {
+		return cfg
+	}
+
+	var raw configFile
+	if err := yaml.Unmarshal(data, &raw); err != nil {
+		return cfg
+	}
+	if raw.ChatTimeout > 0 {
+		cfg.ChatTimeout = time.Duration(raw.ChatTimeout)
+	}
+	if raw.ListTimeout > 0 {
+		cfg.ListTimeout = time.Duration(raw.ListTimeout)
+	}
+	return cfg
+}
@@ -0,0 +1,246 @@
+package client
+
+import (
+	"crypto/rand"
+	"database/sql"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// newID returns a random hex identifier for locally-generated rows
+// (conversations, messages) that never need to round-trip through the
+// daemon's own ID scheme.
+func newID() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return fmt.Sprintf("%d", time.Now().UnixNano())
+	}
+	return fmt.Sprintf("%x", b)
+}
+
+// Conversation is a saved chat thread, scoped to a project. Its messages
+// form a tree (via Message.ParentID) so a single conversation can hold
+// multiple branches.
+type Conversation struct {
+	ID        string
+	ProjectID string
+	Title     string
+	CreatedAt string
+}
+
+// Message is one node in a conversation's branching message tree. An empty
+// ParentID marks the root of the tree.
+type Message struct {
+	ID             string
+	ConversationID string
+	ParentID       string // empty for the root message
+	Role           string // "user" or "assistant"
+	Content        string
+	TokenCount     int
+	CreatedAt      string
+}
+
+// ConversationStore persists chat conversations and their messages in a
+// SQLite database sibling to gobby-hub.db, so the TUI's chat history
+// survives restarts independently of the daemon.
+type ConversationStore struct {
+	db *sql.DB
+}
+
+// NewConversationStore opens (creating if necessary) ~/.gobby/gobby-tui.db
+// and ensures its schema exists.
+func NewConversationStore() (*ConversationStore, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return nil, err
+	}
+	dbPath := filepath.Join(home, ".gobby", "gobby-tui.db")
+
+	db, err := sql.Open("sqlite3", dbPath)
+	if err != nil {
+		return nil, err
+	}
+	if err := db.Ping(); err != nil {
+		return nil, fmt.Errorf("failed to connect to db at %s: %v", dbPath, err)
+	}
+
+	store := &ConversationStore{db: db}
+	if err := store.migrate(); err != nil {
+		return nil, err
+	}
+	return store, nil
+}
+
+func (s *ConversationStore) migrate() error {
+	_, err := s.db.Exec(`
+		CREATE TABLE IF NOT EXISTS conversations (
+			id TEXT PRIMARY KEY,
+			project_id TEXT NOT NULL,
+			title TEXT NOT NULL,
+			created_at TEXT NOT NULL
+		);
+		CREATE TABLE IF NOT EXISTS messages (
+			id TEXT PRIMARY KEY,
+			conversation_id TEXT NOT NULL,
+			parent_id TEXT,
+			role TEXT NOT NULL,
+			content TEXT NOT NULL,
+			token_count INTEGER NOT NULL DEFAULT 0,
+			created_at TEXT NOT NULL
+		);
+		CREATE INDEX IF NOT EXISTS idx_messages_conversation ON messages(conversation_id);
+		CREATE INDEX IF NOT EXISTS idx_messages_parent ON messages(parent_id);
+	`)
+	return err
+}
+
+func (s *ConversationStore) Close() {
+	if s.db != nil {
+		s.db.Close()
+	}
+}
+
+// CreateConversation starts a new, empty conversation for the given project.
+func (s *ConversationStore) CreateConversation(projectID, title string) (Conversation, error) {
+	c := Conversation{
+		ID:        newID(),
+		ProjectID: projectID,
+		Title:     title,
+		CreatedAt: time.Now().UTC().Format(time.RFC3339),
+	}
+	_, err := s.db.Exec(
+		`INSERT INTO conversations (id, project_id, title, created_at) VALUES (?, ?, ?, ?)`,
+		c.ID, c.ProjectID, c.Title, c.CreatedAt,
+	)
+	return c, err
+}
+
+// ListConversations returns every saved conversation for a project, newest first.
+func (s *ConversationStore) ListConversations(projectID string) ([]Conversation, error) {
+	rows, err := s.db.Query(
+		`SELECT id, project_id, title, created_at FROM conversations WHERE project_id = ? ORDER BY created_at DESC`,
+		projectID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []Conversation
+	for rows.Next() {
+		var c Conversation
+		if err := rows.Scan(&c.ID, &c.ProjectID, &c.Title, &c.CreatedAt); err != nil {
+			return nil, err
+		}
+		out = append(out, c)
+	}
+	return out, nil
+}
+
+// RenameConversation updates a conversation's display title.
+func (s *ConversationStore) RenameConversation(id, title string) error {
+	_, err := s.db.Exec(`UPDATE conversations SET title = ? WHERE id = ?`, title, id)
+	return err
+}
+
+// DeleteConversation removes a conversation and all of its messages.
+func (s *ConversationStore) DeleteConversation(id string) error {
+	if _, err := s.db.Exec(`DELETE FROM messages WHERE conversation_id = ?`, id); err != nil {
+		return err
+	}
+	_, err := s.db.Exec(`DELETE FROM conversations WHERE id = ?`, id)
+	return err
+}
+
+// AppendMessage adds a new message under parentID (empty for the tree root),
+// returning the saved Message with its generated ID and timestamp.
+func (s *ConversationStore) AppendMessage(conversationID, parentID, role, content string, tokenCount int) (Message, error) {
+	m := Message{
+		ID:             newID(),
+		ConversationID: conversationID,
+		ParentID:       parentID,
+		Role:           role,
+		Content:        content,
+		TokenCount:     tokenCount,
+		CreatedAt:      time.Now().UTC().Format(time.RFC3339),
+	}
+	var parentArg interface{}
+	if m.ParentID != "" {
+		parentArg = m.ParentID
+	}
+	_, err := s.db.Exec(
+		`INSERT INTO messages (id, conversation_id, parent_id, role, content, token_count, created_at) VALUES (?, ?, ?, ?, ?, ?, ?)`,
+		m.ID, m.ConversationID, parentArg, m.Role, m.Content, m.TokenCount, m.CreatedAt,
+	)
+	return m, err
+}
+
+// ListMessages returns every message in a conversation in insertion order.
+// Callers walk ParentID links to reconstruct the active branch.
+func (s *ConversationStore) ListMessages(conversationID string) ([]Message, error) {
+	rows, err := s.db.Query(
+		`SELECT id, conversation_id, parent_id, role, content, token_count, created_at
+		 FROM messages WHERE conversation_id = ? ORDER BY created_at ASC`,
+		conversationID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []Message
+	for rows.Next() {
+		var m Message
+		var parentID sql.NullString
+		if err := rows.Scan(&m.ID, &m.ConversationID, &parentID, &m.Role, &m.Content, &m.TokenCount, &m.CreatedAt); err != nil {
+			return nil, err
+		}
+		if parentID.Valid {
+			m.ParentID = parentID.String
+		}
+		out = append(out, m)
+	}
+	return out, nil
+}
+
+// Siblings returns every message sharing the given message's parent,
+// ordered by creation time, so callers can cycle between branches.
+func (s *ConversationStore) Siblings(conversationID, parentID string) ([]Message, error) {
+	var rows *sql.Rows
+	var err error
+	if parentID == "" {
+		rows, err = s.db.Query(
+			`SELECT id, conversation_id, parent_id, role, content, token_count, created_at
+			 FROM messages WHERE conversation_id = ? AND parent_id IS NULL ORDER BY created_at ASC`,
+			conversationID,
+		)
+	} else {
+		rows, err = s.db.Query(
+			`SELECT id, conversation_id, parent_id, role, content, token_count, created_at
+			 FROM messages WHERE conversation_id = ? AND parent_id = ? ORDER BY created_at ASC`,
+			conversationID, parentID,
+		)
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []Message
+	for rows.Next() {
+		var m Message
+		var pid sql.NullString
+		if err := rows.Scan(&m.ID, &m.ConversationID, &pid, &m.Role, &m.Content, &m.TokenCount, &m.CreatedAt); err != nil {
+			return nil, err
+		}
+		if pid.Valid {
+			m.ParentID = pid.String
+		}
+		out = append(out, m)
+	}
+	return out, nil
+}
@@ -1,12 +1,15 @@
 package client
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"os"
 	"path/filepath"
 	"strings"
 
+	"gobby-tui/internal/dbutil"
+
 	_ "github.com/mattn/go-sqlite3"
 )
 
@@ -45,35 +48,36 @@ func (c *DBClient) Close() {
 	}
 }
 
+// projectRow mirrors the projects table for queries that need repo_path
+// alongside id/name; RepoPath is a pointer since the column is nullable.
+type projectRow struct {
+	ID       string  `db:"id"`
+	Name     string  `db:"name"`
+	RepoPath *string `db:"repo_path"`
+}
+
 // ResolveProjectID finds the project ID and Name for the current working directory.
 // It relies on the 'projects' table mapping paths to IDs.
-func (c *DBClient) ResolveProjectID(cwd string) (string, string, error) {
+func (c *DBClient) ResolveProjectID(ctx context.Context, cwd string) (string, string, error) {
 	absPath, err := filepath.Abs(cwd)
 	if err != nil {
 		return "", "", err
 	}
 
-	rows, err := c.db.Query("SELECT id, name, repo_path FROM projects")
+	rows, err := dbutil.QueryAll(ctx, c.db, "SELECT id, name, repo_path FROM projects", dbutil.StructScan[projectRow])
 	if err != nil {
 		return "", "", err
 	}
-	defer rows.Close()
 
 	var bestMatchID, bestMatchName string
 	var bestMatchLen int
 
-	for rows.Next() {
-		var id, name string
-		var repoPath sql.NullString
-		if err := rows.Scan(&id, &name, &repoPath); err != nil {
-			continue
-		}
-
-		if !repoPath.Valid {
+	for _, p := range rows {
+		if p.RepoPath == nil {
 			continue
 		}
 
-		path := repoPath.String
+		path := *p.RepoPath
 		rel, err := filepath.Rel(path, absPath)
 		if err != nil {
 			continue
@@ -83,8 +87,8 @@ func (c *DBClient) ResolveProjectID(cwd string) (string, string, error) {
 		if !strings.HasPrefix(rel, "..") {
 			if len(path) > bestMatchLen {
 				bestMatchLen = len(path)
-				bestMatchID = id
-				bestMatchName = name
+				bestMatchID = p.ID
+				bestMatchName = p.Name
 			}
 		}
 	}
@@ -96,116 +100,65 @@ func (c *DBClient) ResolveProjectID(cwd string) (string, string, error) {
 	return bestMatchID, bestMatchName, nil
 }
 
-type Project struct {
-	ID   string
-	Name string
-}
-
-func (c *DBClient) ListProjects() ([]Project, error) {
-	rows, err := c.db.Query("SELECT id, name FROM projects ORDER BY name")
+// ProjectRepoPath looks up the repo_path column for a single project, used
+// to point the filesystem watcher at the right working tree.
+func (c *DBClient) ProjectRepoPath(ctx context.Context, projectID string) (string, error) {
+	row, err := dbutil.QueryOne(ctx, c.db, "SELECT id, name, repo_path FROM projects WHERE id = ?", dbutil.StructScan[projectRow], projectID)
 	if err != nil {
-		return nil, err
+		return "", err
 	}
-	defer rows.Close()
-
-	var projects []Project
-	for rows.Next() {
-		var p Project
-		if err := rows.Scan(&p.ID, &p.Name); err != nil {
-			return nil, err
-		}
-		projects = append(projects, p)
+	if row.RepoPath == nil {
+		return "", nil
 	}
-	return projects, nil
+	return *row.RepoPath, nil
 }
 
-// TaskBrief is a minimal task representation for lists
-type TaskBrief struct {
-	ID        string
-	Title     string
-	Status    string
-	Priority  int
-	SeqNum    int
-	ProjectID string
+type Project struct {
+	ID   string `db:"id"`
+	Name string `db:"name"`
 }
 
-func (c *DBClient) ListTasks(projectID string, status string) ([]TaskBrief, error) {
-	query := "SELECT id, title, status, priority, seq_num, project_id FROM tasks WHERE 1=1"
-	var args []interface{}
-
-	if projectID != "" {
-		query += " AND project_id = ?"
-		args = append(args, projectID)
-	}
+func (c *DBClient) ListProjects(ctx context.Context) ([]Project, error) {
+	return dbutil.QueryAll(ctx, c.db, "SELECT id, name FROM projects ORDER BY name", dbutil.StructScan[Project])
+}
 
-	if status != "" {
-		query += " AND status = ?"
-		args = append(args, status)
-	}
+// TaskBrief is a minimal task representation for lists. Description and
+// ParentID are pointers since both columns are nullable.
+type TaskBrief struct {
+	ID          string  `db:"id"`
+	Title       string  `db:"title"`
+	Status      string  `db:"status"`
+	Priority    int     `db:"priority"`
+	SeqNum      int     `db:"seq_num"`
+	ProjectID   string  `db:"project_id"`
+	Description *string `db:"description"`
+	ParentID    *string `db:"parent_id"`
+}
 
-	query += " ORDER BY priority ASC, created_at ASC"
+func (c *DBClient) ListTasks(ctx context.Context, projectID string, status string) ([]TaskBrief, error) {
+	where, args := dbutil.NewWhere().
+		Eq("project_id", projectID).
+		Eq("status", status).
+		SQL()
 
-	rows, err := c.db.Query(query, args...)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-
-	var tasks []TaskBrief
-	for rows.Next() {
-		var t TaskBrief
-		var seqNum sql.NullInt64
-		if err := rows.Scan(&t.ID, &t.Title, &t.Status, &t.Priority, &seqNum, &t.ProjectID); err != nil {
-			return nil, err
-		}
-		if seqNum.Valid {
-			t.SeqNum = int(seqNum.Int64)
-		}
-		tasks = append(tasks, t)
-	}
-	return tasks, nil
+	query := "SELECT id, title, status, priority, seq_num, project_id, description, parent_id FROM tasks" + where + " ORDER BY priority ASC, created_at ASC"
+	return dbutil.QueryAll(ctx, c.db, query, dbutil.StructScan[TaskBrief], args...)
 }
 
 type SessionBrief struct {
-	ID         string
-	Status     string
-	ExternalID string
-	Source     string
-	ProjectID  string
-	UpdatedAt  string
+	ID         string `db:"id"`
+	Status     string `db:"status"`
+	ExternalID string `db:"external_id"`
+	Source     string `db:"source"`
+	ProjectID  string `db:"project_id"`
+	UpdatedAt  string `db:"updated_at"`
 }
 
-func (c *DBClient) ListSessions(projectID string) ([]SessionBrief, error) {
-	query := "SELECT id, status, external_id, source, project_id, updated_at FROM sessions WHERE 1=1"
-	var args []interface{}
-
-	if projectID != "" {
-		query += " AND project_id = ?"
-		args = append(args, projectID)
-	}
-
-	query += " ORDER BY updated_at DESC"
+func (c *DBClient) ListSessions(ctx context.Context, projectID string) ([]SessionBrief, error) {
+	where, args := dbutil.NewWhere().
+		Eq("project_id", projectID).
+		SQL()
 
-	rows, err := c.db.Query(query, args...)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-
-	var sessions []SessionBrief
-	for rows.Next() {
-		var s SessionBrief
-		var eid, src sql.NullString
-		if err := rows.Scan(&s.ID, &s.Status, &eid, &src, &s.ProjectID, &s.UpdatedAt); err != nil {
-			return nil, err
-		}
-		if eid.Valid {
-			s.ExternalID = eid.String
-		}
-		if src.Valid {
-			s.Source = src.String
-		}
-		sessions = append(sessions, s)
-	}
-	return sessions, nil
+	query := "SELECT id, status, external_id, source, project_id, updated_at FROM sessions" + where + " ORDER BY updated_at DESC"
+	return dbutil.QueryAll(ctx, c.db, query, dbutil.StructScan[SessionBrief], args...)
 }
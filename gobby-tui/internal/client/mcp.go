@@ -1,85 +1,138 @@
 package client
 
 import (
+	"context"
 	"fmt"
 )
 
 // MCP Wrappers for Gobby Tasks
 
 type Task struct {
-	ID       string `json:"id"`
-	Title    string `json:"title"`
-	Status   string `json:"status"`
-	Priority int    `json:"priority"`
-	SeqNum   int    `json:"seq_num"`
+	ID          string `json:"id"`
+	Title       string `json:"title"`
+	Status      string `json:"status"`
+	Priority    int    `json:"priority"`
+	SeqNum      int    `json:"seq_num"`
+	Description string `json:"description"`
+	ParentID    string `json:"parent_id"`
 	// Add filter method helpers?
 }
 
 // ListTasks now uses Direct DB Access
-func (c *GobbyClient) ListTasks(status string) ([]Task, error) {
+func (c *GobbyClient) ListTasks(ctx context.Context, status string) ([]Task, error) {
 	if c.db == nil {
 		return nil, fmt.Errorf("database not connected")
 	}
 
-	rawTasks, err := c.db.ListTasks(c.ProjectID, status)
+	rawTasks, err := c.db.ListTasks(ctx, c.ProjectID, status)
 	if err != nil {
 		return nil, err
 	}
 
 	var tasks []Task
 	for _, t := range rawTasks {
-		tasks = append(tasks, Task{
+		task := Task{
 			ID:       t.ID,
 			Title:    t.Title,
 			Status:   t.Status,
 			Priority: t.Priority,
 			SeqNum:   t.SeqNum,
-		})
+		}
+		if t.Description != nil {
+			task.Description = *t.Description
+		}
+		if t.ParentID != nil {
+			task.ParentID = *t.ParentID
+		}
+		tasks = append(tasks, task)
 	}
 	return tasks, nil
 }
 
-func (c *GobbyClient) ListReadyTasks() ([]Task, error) {
+func (c *GobbyClient) ListReadyTasks(ctx context.Context) ([]Task, error) {
 	// For now, return all non-closed tasks?
 	// Or just return all tasks and let the UI filter.
 	// Since DB ListTasks with empty status returns all.
-	return c.ListTasks("")
+	return c.ListTasks(ctx, "")
 }
 
-func (c *GobbyClient) CreateTask(title string) error {
-	_, err := c.CallTool("gobby-tasks", "create_task", map[string]interface{}{
+func (c *GobbyClient) CreateTask(ctx context.Context, title string) error {
+	_, err := c.CallTool(ctx, "gobby-tasks", "create_task", map[string]interface{}{
 		"title": title,
 	})
 	return err
 }
 
-func (c *GobbyClient) UpdateTask(id, status string) error {
-	_, err := c.CallTool("gobby-tasks", "update_task", map[string]interface{}{
+func (c *GobbyClient) UpdateTask(ctx context.Context, id, status string) error {
+	_, err := c.CallTool(ctx, "gobby-tasks", "update_task", map[string]interface{}{
 		"id":     id,
 		"status": status,
 	})
 	return err
 }
 
-func (c *GobbyClient) DeleteTask(id string) error {
-	_, err := c.CallTool("gobby-tasks", "delete_task", map[string]interface{}{
+// TaskFormData is the full set of user-editable task fields, collected by
+// TaskFormPane and forwarded to the gobby-tasks MCP tools as-is.
+type TaskFormData struct {
+	Title       string
+	Description string
+	Priority    int
+	Status      string
+	ParentID    string
+}
+
+// CreateTaskFull forwards the full field set TaskFormData collects to the
+// gobby-tasks create_task tool, unlike CreateTask which only sets a title.
+func (c *GobbyClient) CreateTaskFull(ctx context.Context, data TaskFormData) error {
+	args := map[string]interface{}{
+		"title":       data.Title,
+		"description": data.Description,
+		"priority":    data.Priority,
+		"status":      data.Status,
+	}
+	if data.ParentID != "" {
+		args["parent_id"] = data.ParentID
+	}
+	_, err := c.CallTool(ctx, "gobby-tasks", "create_task", args)
+	return err
+}
+
+// UpdateTaskFull forwards the full field set TaskFormData collects to the
+// gobby-tasks update_task tool, unlike UpdateTask which only sets status.
+func (c *GobbyClient) UpdateTaskFull(ctx context.Context, id string, data TaskFormData) error {
+	args := map[string]interface{}{
+		"id":          id,
+		"title":       data.Title,
+		"description": data.Description,
+		"priority":    data.Priority,
+		"status":      data.Status,
+	}
+	if data.ParentID != "" {
+		args["parent_id"] = data.ParentID
+	}
+	_, err := c.CallTool(ctx, "gobby-tasks", "update_task", args)
+	return err
+}
+
+func (c *GobbyClient) DeleteTask(ctx context.Context, id string) error {
+	_, err := c.CallTool(ctx, "gobby-tasks", "delete_task", map[string]interface{}{
 		"id": id,
 	})
 	return err
 }
 
-func (c *GobbyClient) SpawnAgent(name string) error {
-	_, err := c.CallTool("gobby-agents", "spawn_agent", map[string]interface{}{
+func (c *GobbyClient) SpawnAgent(ctx context.Context, name string) error {
+	_, err := c.CallTool(ctx, "gobby-agents", "spawn_agent", map[string]interface{}{
 		"name": name,
 	})
 	return err
 }
 
-func (c *GobbyClient) ListProjects() ([]Project, error) {
+func (c *GobbyClient) ListProjects(ctx context.Context) ([]Project, error) {
 	if c.db == nil {
 		return nil, fmt.Errorf("database not connected")
 	}
-	return c.db.ListProjects()
+	return c.db.ListProjects(ctx)
 }
 
 type Session struct {
@@ -89,12 +142,12 @@ type Session struct {
 	Source     string `json:"source"`
 }
 
-func (c *GobbyClient) ListSessions() ([]Session, error) {
+func (c *GobbyClient) ListSessions(ctx context.Context) ([]Session, error) {
 	if c.db == nil {
 		return nil, fmt.Errorf("database not connected")
 	}
 
-	rawSessions, err := c.db.ListSessions(c.ProjectID)
+	rawSessions, err := c.db.ListSessions(ctx, c.ProjectID)
 	if err != nil {
 		return nil, err
 	}
@@ -110,22 +163,3 @@ func (c *GobbyClient) ListSessions() ([]Session, error) {
 	}
 	return sessions, nil
 }
-
-func (c *GobbyClient) Chat(prompt, parentSessionID string) (string, error) {
-	// Call start_agent in in_process mode to get a synchronous response
-	result, err := c.CallTool("gobby-agents", "start_agent", map[string]interface{}{
-		"prompt":            prompt,
-		"mode":              "in_process",
-		"parent_session_id": parentSessionID,
-		"max_turns":         5,
-		"provider":          "claude", // Use default executor
-	})
-	if err != nil {
-		return "", err
-	}
-
-	if output, ok := result["output"].(string); ok {
-		return output, nil
-	}
-	return "", fmt.Errorf("no output in agent result")
-}
@@ -0,0 +1,128 @@
+package client
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// ChatEventType categorizes the events emitted while an agent turn streams
+// in over ChatStream.
+type ChatEventType string
+
+const (
+	ChatEventToken      ChatEventType = "token"
+	ChatEventToolCall   ChatEventType = "tool_call"
+	ChatEventToolResult ChatEventType = "tool_result"
+	ChatEventDone       ChatEventType = "done"
+	ChatEventError      ChatEventType = "error"
+)
+
+// ChatEvent is one Server-Sent Event emitted by the daemon's
+// /mcp/tools/stream endpoint while an agent turn is in progress.
+type ChatEvent struct {
+	Type ChatEventType
+	Data string
+}
+
+// ChatStream opens a streaming connection to the daemon and emits one
+// ChatEvent per SSE frame on the returned channel, which is closed once the
+// daemon sends a "done" or "error" event or ends the body, ctx is cancelled
+// or times out, or the returned cancel func is called. Cancelling ctx or
+// calling cancel closes the underlying response body, which unblocks the
+// read loop immediately.
+func (c *GobbyClient) ChatStream(ctx context.Context, prompt, parentSessionID string, allowedTools []string) (<-chan ChatEvent, func(), error) {
+	payload := map[string]interface{}{
+		"server_name": "gobby-agents",
+		"tool_name":   "start_agent",
+		"arguments": map[string]interface{}{
+			"prompt":            prompt,
+			"mode":              "in_process",
+			"parent_session_id": parentSessionID,
+			"max_turns":         5,
+			"provider":          "claude",
+			"stream":            true,
+		},
+	}
+	if len(allowedTools) > 0 {
+		payload["arguments"].(map[string]interface{})["allowed_tools"] = allowedTools
+	}
+
+	jsonData, err := json.Marshal(payload)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to marshal args: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, DaemonBaseURL+"/mcp/tools/stream", bytes.NewBuffer(jsonData))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to build request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "text/event-stream")
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, nil, fmt.Errorf("daemon request failed: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, nil, fmt.Errorf("daemon error (%d)", resp.StatusCode)
+	}
+
+	events := make(chan ChatEvent)
+	go readSSE(resp.Body, events)
+
+	cancel := func() { resp.Body.Close() }
+	return events, cancel, nil
+}
+
+// readSSE parses the standard "event: <type>\ndata: <payload>\n\n" framing
+// and forwards each frame as a ChatEvent. It closes events after a "done" or
+// "error" frame, or when the body is exhausted or closed by the caller's
+// cancel func.
+func readSSE(body io.ReadCloser, events chan<- ChatEvent) {
+	defer close(events)
+	defer body.Close()
+
+	reader := bufio.NewReader(body)
+	var eventType, data string
+
+	flush := func() bool {
+		if eventType == "" && data == "" {
+			return true
+		}
+		if eventType == "" {
+			eventType = string(ChatEventToken)
+		}
+		events <- ChatEvent{Type: ChatEventType(eventType), Data: data}
+		done := eventType == string(ChatEventDone) || eventType == string(ChatEventError)
+		eventType, data = "", ""
+		return !done
+	}
+
+	for {
+		line, err := reader.ReadString('\n')
+		trimmed := strings.TrimRight(line, "\r\n")
+
+		switch {
+		case strings.HasPrefix(trimmed, "event:"):
+			eventType = strings.TrimSpace(strings.TrimPrefix(trimmed, "event:"))
+		case strings.HasPrefix(trimmed, "data:"):
+			data += strings.TrimSpace(strings.TrimPrefix(trimmed, "data:"))
+		case trimmed == "":
+			if !flush() {
+				return
+			}
+		}
+
+		if err != nil {
+			flush()
+			return
+		}
+	}
+}
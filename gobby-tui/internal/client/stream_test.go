@@ -0,0 +1,124 @@
+package client
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// fakeSSEServer starts an httptest.Server that writes frames verbatim to
+// whoever connects, mirroring how the daemon's /mcp/tools/stream endpoint
+// writes "event: ...\ndata: ...\n\n" frames.
+func fakeSSEServer(t *testing.T, frames string) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, frames)
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestReadSSE(t *testing.T) {
+	srv := fakeSSEServer(t, "event: token\ndata: hello\n\nevent: token\ndata: world\n\nevent: done\ndata: \n\n")
+
+	resp, err := http.Get(srv.URL)
+	if err != nil {
+		t.Fatalf("GET: %v", err)
+	}
+
+	events := make(chan ChatEvent)
+	go readSSE(resp.Body, events)
+
+	var got []ChatEvent
+	for ev := range events {
+		got = append(got, ev)
+	}
+
+	want := []ChatEvent{
+		{Type: ChatEventToken, Data: "hello"},
+		{Type: ChatEventToken, Data: "world"},
+		{Type: ChatEventDone, Data: ""},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("got %d events, want %d: %+v", len(got), len(want), got)
+	}
+	for i, ev := range got {
+		if ev != want[i] {
+			t.Errorf("event %d: got %+v, want %+v", i, ev, want[i])
+		}
+	}
+}
+
+func TestReadSSEDefaultsMissingEventTypeToToken(t *testing.T) {
+	srv := fakeSSEServer(t, "data: no event line\n\n")
+
+	resp, err := http.Get(srv.URL)
+	if err != nil {
+		t.Fatalf("GET: %v", err)
+	}
+
+	events := make(chan ChatEvent)
+	go readSSE(resp.Body, events)
+
+	ev, ok := <-events
+	if !ok {
+		t.Fatal("expected one event, got none")
+	}
+	if ev.Type != ChatEventToken || ev.Data != "no event line" {
+		t.Fatalf("unexpected event: %+v", ev)
+	}
+}
+
+func TestReadSSEStopsOnError(t *testing.T) {
+	srv := fakeSSEServer(t, "event: token\ndata: one\n\nevent: error\ndata: boom\n\nevent: token\ndata: should not arrive\n\n")
+
+	resp, err := http.Get(srv.URL)
+	if err != nil {
+		t.Fatalf("GET: %v", err)
+	}
+
+	events := make(chan ChatEvent)
+	go readSSE(resp.Body, events)
+
+	var got []ChatEvent
+	for ev := range events {
+		got = append(got, ev)
+	}
+
+	if len(got) != 2 {
+		t.Fatalf("got %d events, want 2 (stream should stop after the error frame): %+v", len(got), got)
+	}
+	if got[1].Type != ChatEventError || got[1].Data != "boom" {
+		t.Fatalf("unexpected second event: %+v", got[1])
+	}
+}
+
+// TestReadSSECancelUnblocksReader exercises the cancel func ChatStream hands
+// back: closing the response body mid-stream should unblock readSSE's
+// ReadString instead of hanging.
+func TestReadSSECancelUnblocksReader(t *testing.T) {
+	pr, pw := io.Pipe()
+	events := make(chan ChatEvent)
+	go readSSE(io.NopCloser(pr), events)
+
+	fmt.Fprint(pw, "event: token\ndata: partial\n\n")
+	if ev := <-events; ev.Data != "partial" {
+		t.Fatalf("unexpected event: %+v", ev)
+	}
+
+	pr.Close()
+
+	select {
+	case _, ok := <-events:
+		if ok {
+			t.Fatal("expected events to be closed after the body closed")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("readSSE did not close events after the body closed")
+	}
+}
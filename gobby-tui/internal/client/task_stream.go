@@ -0,0 +1,97 @@
+package client
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// TaskEventType categorizes a single frame from the daemon's /tasks/stream
+// endpoint.
+type TaskEventType string
+
+const (
+	TaskEventUpserted TaskEventType = "upserted"
+	TaskEventDeleted  TaskEventType = "deleted"
+)
+
+// TaskEvent is one task create/update/delete pushed by /tasks/stream. Task
+// is populated for TaskEventUpserted; only Task.ID is meaningful for
+// TaskEventDeleted.
+type TaskEvent struct {
+	Type TaskEventType `json:"type"`
+	Task Task          `json:"task"`
+}
+
+// TaskStream opens a long-lived SSE connection to the daemon's live task
+// feed, if it exposes one. Not every daemon version does, so callers that
+// get an error should fall back to the filesystem Watcher instead of
+// retrying. The returned channel is closed when ctx is cancelled or the
+// daemon closes the connection; the cancel func closes the underlying
+// response body immediately, unblocking the read loop.
+func (c *GobbyClient) TaskStream(ctx context.Context) (<-chan TaskEvent, func(), error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, DaemonBaseURL+"/tasks/stream", nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to build request: %w", err)
+	}
+	req.Header.Set("Accept", "text/event-stream")
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, nil, fmt.Errorf("daemon request failed: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, nil, fmt.Errorf("daemon error (%d)", resp.StatusCode)
+	}
+
+	events := make(chan TaskEvent)
+	go readTaskSSE(resp.Body, events)
+
+	cancel := func() { resp.Body.Close() }
+	return events, cancel, nil
+}
+
+// readTaskSSE parses "data: <json>\n\n" frames into TaskEvents, mirroring
+// readSSE's framing but decoding a structured payload instead of a raw
+// token string. A frame that fails to decode is dropped rather than killing
+// the stream.
+func readTaskSSE(body io.ReadCloser, events chan<- TaskEvent) {
+	defer close(events)
+	defer body.Close()
+
+	reader := bufio.NewReader(body)
+	var data string
+
+	flush := func() {
+		if data == "" {
+			return
+		}
+		var ev TaskEvent
+		if err := json.Unmarshal([]byte(data), &ev); err == nil {
+			events <- ev
+		}
+		data = ""
+	}
+
+	for {
+		line, err := reader.ReadString('\n')
+		trimmed := strings.TrimRight(line, "\r\n")
+
+		switch {
+		case strings.HasPrefix(trimmed, "data:"):
+			data += strings.TrimSpace(strings.TrimPrefix(trimmed, "data:"))
+		case trimmed == "":
+			flush()
+		}
+
+		if err != nil {
+			flush()
+			return
+		}
+	}
+}
@@ -0,0 +1,166 @@
+package client
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// WatchKind identifies what changed on disk.
+type WatchKind string
+
+const (
+	WatchTasks    WatchKind = "tasks"    // gobby-hub.db (tasks table) changed
+	WatchSessions WatchKind = "sessions" // gobby-hub.db (sessions table) changed
+	WatchFiles    WatchKind = "files"    // the active project's repo_path changed
+)
+
+// WatchEvent is emitted whenever the watched database or project tree
+// changes, after debouncing.
+type WatchEvent struct {
+	Kind WatchKind
+	At   time.Time
+}
+
+// debounceWindow coalesces bursts of SQLite WAL writes (which touch the
+// main db file, -wal, and -shm several times per transaction) into a single
+// event per kind.
+const debounceWindow = 250 * time.Millisecond
+
+// Watcher watches ~/.gobby/gobby-hub.db (and its -wal/-shm sidecars) plus
+// the current project's repo_path, and emits a debounced WatchEvent per
+// change on Events().
+type Watcher struct {
+	fsw    *fsnotify.Watcher
+	events chan WatchEvent
+	done   chan struct{} // closed once run() returns
+
+	mu     sync.Mutex
+	timers map[WatchKind]*time.Timer
+	wg     sync.WaitGroup // tracks in-flight fire() timer callbacks
+}
+
+// NewWatcher starts watching the daemon's database directory and, if
+// repoPath is non-empty, the resolved project's working tree.
+func NewWatcher(repoPath string) (*Watcher, error) {
+	fsw, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, err
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		fsw.Close()
+		return nil, err
+	}
+	gobbyDir := filepath.Join(home, ".gobby")
+	if err := fsw.Add(gobbyDir); err != nil {
+		fsw.Close()
+		return nil, err
+	}
+
+	if repoPath != "" {
+		// Best-effort: a missing or inaccessible repo shouldn't stop the
+		// daemon-side watch from working.
+		fsw.Add(repoPath)
+	}
+
+	w := &Watcher{
+		fsw:    fsw,
+		events: make(chan WatchEvent),
+		done:   make(chan struct{}),
+		timers: map[WatchKind]*time.Timer{},
+	}
+	go w.run()
+	return w, nil
+}
+
+// Events returns the channel of debounced change notifications.
+func (w *Watcher) Events() <-chan WatchEvent {
+	return w.events
+}
+
+// Close stops the underlying fsnotify watcher and its goroutine, cancels any
+// pending debounce timers, and waits for ones already in flight to finish
+// before closing Events() — otherwise a timer that fires mid-Close could
+// send on a channel run() has already closed.
+func (w *Watcher) Close() {
+	w.fsw.Close()
+	<-w.done
+
+	w.mu.Lock()
+	for _, t := range w.timers {
+		if t.Stop() {
+			w.wg.Done()
+		}
+	}
+	w.mu.Unlock()
+	w.wg.Wait()
+
+	close(w.events)
+}
+
+func (w *Watcher) fire(kind WatchKind) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if t, ok := w.timers[kind]; ok && t.Stop() {
+		// The stopped callback will never run to release its count.
+		w.wg.Done()
+	}
+	w.wg.Add(1)
+	w.timers[kind] = time.AfterFunc(debounceWindow, func() {
+		defer w.wg.Done()
+		w.events <- WatchEvent{Kind: kind, At: time.Now()}
+	})
+}
+
+func (w *Watcher) run() {
+	defer close(w.done)
+
+	for {
+		select {
+		case event, ok := <-w.fsw.Events:
+			if !ok {
+				return
+			}
+			switch classify(event.Name) {
+			case WatchTasks:
+				w.fire(WatchTasks)
+				w.fire(WatchSessions)
+			case WatchFiles:
+				w.fire(WatchFiles)
+			default:
+				// Our own conversation store writes under ~/.gobby too;
+				// ignore them so saving a chat message doesn't look like a
+				// project file change.
+			}
+		case _, ok := <-w.fsw.Errors:
+			if !ok {
+				return
+			}
+		}
+	}
+}
+
+// classify maps a changed path to the WatchKind it represents, or "" if the
+// path isn't one we care about. Writes to the hub database (or its WAL/SHM
+// sidecars) are reported as WatchTasks; the caller fans that out to both
+// tasks and sessions since both tables live in the same file and fsnotify
+// can't tell rows apart. Anything outside ~/.gobby is assumed to be inside
+// the watched repo_path and reported as WatchFiles.
+func classify(name string) WatchKind {
+	base := filepath.Base(name)
+	switch base {
+	case "gobby-hub.db", "gobby-hub.db-wal", "gobby-hub.db-shm":
+		return WatchTasks
+	case "gobby-tui.db", "gobby-tui.db-wal", "gobby-tui.db-shm":
+		return ""
+	}
+	if home, err := os.UserHomeDir(); err == nil && filepath.Dir(name) == filepath.Join(home, ".gobby") {
+		return ""
+	}
+	return WatchFiles
+}
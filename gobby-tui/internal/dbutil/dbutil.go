@@ -0,0 +1,205 @@
+// Package dbutil provides generic, type-safe query helpers that replace the
+// Query → rows.Next → Scan → append boilerplate duplicated across
+// DBClient's list methods, plus a small struct-tag-driven row scanner and a
+// Where builder for optional filters.
+package dbutil
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Queryer is the subset of *sql.DB used by QueryAll/QueryOne; *sql.DB and
+// *sql.Tx both satisfy it.
+type Queryer interface {
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+}
+
+// ScanFunc builds one T from the current row.
+type ScanFunc[T any] func(*sql.Rows) (T, error)
+
+// QueryAll runs query and collects one T per row via scan.
+func QueryAll[T any](ctx context.Context, q Queryer, query string, scan ScanFunc[T], args ...interface{}) ([]T, error) {
+	rows, err := q.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []T
+	for rows.Next() {
+		v, err := scan(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, v)
+	}
+	return out, rows.Err()
+}
+
+// QueryOne runs query and returns the first row, or sql.ErrNoRows if it
+// matched nothing.
+func QueryOne[T any](ctx context.Context, q Queryer, query string, scan ScanFunc[T], args ...interface{}) (T, error) {
+	var zero T
+	all, err := QueryAll(ctx, q, query, scan, args...)
+	if err != nil {
+		return zero, err
+	}
+	if len(all) == 0 {
+		return zero, sql.ErrNoRows
+	}
+	return all[0], nil
+}
+
+// StructScan is a ScanFunc that fills a new T (which must be a struct) by
+// matching `db:"..."` tags to the query's column names. Columns with no
+// matching tag are ignored; NULL values leave the field at its zero value.
+// Pointer fields (e.g. *string) and sql.Null* fields are both handled, the
+// latter via their Scan method.
+func StructScan[T any](rows *sql.Rows) (T, error) {
+	var out T
+
+	cols, err := rows.Columns()
+	if err != nil {
+		return out, err
+	}
+
+	v := reflect.ValueOf(&out).Elem()
+	if v.Kind() != reflect.Struct {
+		return out, fmt.Errorf("dbutil: StructScan requires a struct type, got %s", v.Kind())
+	}
+
+	fieldByCol := make(map[string]reflect.Value, v.NumField())
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		tag := t.Field(i).Tag.Get("db")
+		if tag == "" {
+			continue
+		}
+		fieldByCol[tag] = v.Field(i)
+	}
+
+	raws := make([]interface{}, len(cols))
+	for i := range cols {
+		var raw interface{}
+		raws[i] = &raw
+	}
+	if err := rows.Scan(raws...); err != nil {
+		return out, err
+	}
+
+	for i, col := range cols {
+		field, ok := fieldByCol[col]
+		if !ok {
+			continue
+		}
+		raw := *(raws[i].(*interface{}))
+		if err := assign(field, raw); err != nil {
+			return out, fmt.Errorf("dbutil: column %q: %w", col, err)
+		}
+	}
+	return out, nil
+}
+
+// assign copies a driver value into field, treating nil as "leave at zero
+// value" and recursing through pointer fields so *string/*int64 etc. are
+// allocated lazily.
+func assign(field reflect.Value, raw interface{}) error {
+	if raw == nil {
+		return nil
+	}
+
+	if field.Kind() == reflect.Ptr {
+		if field.IsNil() {
+			field.Set(reflect.New(field.Type().Elem()))
+		}
+		return assign(field.Elem(), raw)
+	}
+
+	if scanner, ok := field.Addr().Interface().(sql.Scanner); ok {
+		return scanner.Scan(raw)
+	}
+
+	switch field.Kind() {
+	case reflect.String:
+		switch v := raw.(type) {
+		case string:
+			field.SetString(v)
+		case []byte:
+			field.SetString(string(v))
+		default:
+			field.SetString(fmt.Sprint(v))
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		switch v := raw.(type) {
+		case int64:
+			field.SetInt(v)
+		case float64:
+			field.SetInt(int64(v))
+		default:
+			return fmt.Errorf("cannot assign %T into %s", raw, field.Kind())
+		}
+	case reflect.Bool:
+		switch v := raw.(type) {
+		case bool:
+			field.SetBool(v)
+		case int64:
+			field.SetBool(v != 0)
+		default:
+			return fmt.Errorf("cannot assign %T into bool", raw)
+		}
+	default:
+		return fmt.Errorf("unsupported field kind %s", field.Kind())
+	}
+	return nil
+}
+
+// Where accumulates optional "col = ?" conditions, replacing the
+// "WHERE 1=1" string-concatenation pattern used throughout DBClient's list
+// queries.
+type Where struct {
+	conds []string
+	args  []interface{}
+}
+
+// NewWhere returns an empty Where.
+func NewWhere() *Where {
+	return &Where{}
+}
+
+// Eq adds "col = ?" unless val is the zero value for its type (the same
+// "skip if unset" behavior the old ad-hoc filters relied on).
+func (w *Where) Eq(col string, val interface{}) *Where {
+	if isZero(val) {
+		return w
+	}
+	w.conds = append(w.conds, col+" = ?")
+	w.args = append(w.args, val)
+	return w
+}
+
+// SQL renders the accumulated conditions as " WHERE a = ? AND b = ?" (with
+// a leading space, ready to append to a base query), or "" if none were
+// added, along with their positional args.
+func (w *Where) SQL() (string, []interface{}) {
+	if len(w.conds) == 0 {
+		return "", nil
+	}
+	return " WHERE " + strings.Join(w.conds, " AND "), w.args
+}
+
+func isZero(v interface{}) bool {
+	switch val := v.(type) {
+	case string:
+		return val == ""
+	case int:
+		return val == 0
+	case nil:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,164 @@
+package dbutil
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// openFixture returns an in-memory SQLite database seeded with the subset of
+// the gobby-hub schema dbutil's callers actually query: projects, tasks, and
+// sessions.
+func openFixture(t *testing.T) *sql.DB {
+	t.Helper()
+
+	db, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		t.Fatalf("open: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	schema := `
+		CREATE TABLE projects (
+			id TEXT PRIMARY KEY,
+			name TEXT NOT NULL,
+			repo_path TEXT
+		);
+		CREATE TABLE tasks (
+			id TEXT PRIMARY KEY,
+			project_id TEXT NOT NULL,
+			title TEXT NOT NULL,
+			status TEXT NOT NULL,
+			priority INTEGER NOT NULL,
+			seq_num INTEGER NOT NULL,
+			description TEXT,
+			parent_id TEXT,
+			created_at TEXT NOT NULL
+		);
+		CREATE TABLE sessions (
+			id TEXT PRIMARY KEY,
+			status TEXT NOT NULL,
+			external_id TEXT,
+			source TEXT
+		);
+	`
+	if _, err := db.Exec(schema); err != nil {
+		t.Fatalf("create schema: %v", err)
+	}
+
+	seed := `
+		INSERT INTO projects (id, name, repo_path) VALUES
+			('proj-1', 'Gobby', '/repo/gobby'),
+			('proj-2', 'NoRepo', NULL);
+		INSERT INTO tasks (id, project_id, title, status, priority, seq_num, description, parent_id, created_at) VALUES
+			('task-1', 'proj-1', 'Write docs', 'todo', 2, 1, 'Cover the CLI flags', NULL, '2026-01-01'),
+			('task-2', 'proj-1', 'Fix bug', 'done', 1, 2, NULL, 'task-1', '2026-01-02'),
+			('task-3', 'proj-2', 'Other project task', 'todo', 3, 1, NULL, NULL, '2026-01-03');
+		INSERT INTO sessions (id, status, external_id, source) VALUES
+			('sess-1', 'open', 'ext-1', 'cli'),
+			('sess-2', 'closed', NULL, NULL);
+	`
+	if _, err := db.Exec(seed); err != nil {
+		t.Fatalf("seed: %v", err)
+	}
+
+	return db
+}
+
+type fixtureTask struct {
+	ID          string  `db:"id"`
+	ProjectID   string  `db:"project_id"`
+	Title       string  `db:"title"`
+	Status      string  `db:"status"`
+	Priority    int     `db:"priority"`
+	SeqNum      int     `db:"seq_num"`
+	Description *string `db:"description"`
+	ParentID    *string `db:"parent_id"`
+}
+
+type fixtureSession struct {
+	ID         string  `db:"id"`
+	Status     string  `db:"status"`
+	ExternalID *string `db:"external_id"`
+}
+
+func TestQueryAll(t *testing.T) {
+	db := openFixture(t)
+	ctx := context.Background()
+
+	tasks, err := QueryAll(ctx, db, "SELECT id, project_id, title, status, priority, seq_num, description, parent_id FROM tasks ORDER BY seq_num ASC, project_id ASC", StructScan[fixtureTask])
+	if err != nil {
+		t.Fatalf("QueryAll: %v", err)
+	}
+	if len(tasks) != 3 {
+		t.Fatalf("got %d tasks, want 3", len(tasks))
+	}
+
+	first := tasks[0]
+	if first.ID != "task-1" || first.Title != "Write docs" {
+		t.Fatalf("unexpected first task: %+v", first)
+	}
+	if first.Description == nil || *first.Description != "Cover the CLI flags" {
+		t.Fatalf("expected description to be populated, got %+v", first.Description)
+	}
+	if first.ParentID != nil {
+		t.Fatalf("expected nil parent_id, got %v", *first.ParentID)
+	}
+
+	var withParent fixtureTask
+	for _, task := range tasks {
+		if task.ID == "task-2" {
+			withParent = task
+		}
+	}
+	if withParent.ParentID == nil || *withParent.ParentID != "task-1" {
+		t.Fatalf("expected task-2 to carry parent_id task-1, got %+v", withParent.ParentID)
+	}
+	if withParent.Description != nil {
+		t.Fatalf("expected nil description for task-2, got %v", *withParent.Description)
+	}
+}
+
+func TestQueryOne(t *testing.T) {
+	db := openFixture(t)
+	ctx := context.Background()
+
+	session, err := QueryOne(ctx, db, "SELECT id, status, external_id FROM sessions WHERE id = ?", StructScan[fixtureSession], "sess-1")
+	if err != nil {
+		t.Fatalf("QueryOne: %v", err)
+	}
+	if session.Status != "open" || session.ExternalID == nil || *session.ExternalID != "ext-1" {
+		t.Fatalf("unexpected session: %+v", session)
+	}
+
+	if _, err := QueryOne(ctx, db, "SELECT id, status, external_id FROM sessions WHERE id = ?", StructScan[fixtureSession], "missing"); err != sql.ErrNoRows {
+		t.Fatalf("expected sql.ErrNoRows, got %v", err)
+	}
+}
+
+func TestWhereSkipsZeroValues(t *testing.T) {
+	db := openFixture(t)
+	ctx := context.Background()
+
+	where, args := NewWhere().Eq("project_id", "proj-1").Eq("status", "").SQL()
+	if where != " WHERE project_id = ?" {
+		t.Fatalf("unexpected where clause: %q", where)
+	}
+
+	tasks, err := QueryAll(ctx, db, "SELECT id, project_id, title, status, priority, seq_num, description, parent_id FROM tasks"+where, StructScan[fixtureTask], args...)
+	if err != nil {
+		t.Fatalf("QueryAll: %v", err)
+	}
+	if len(tasks) != 2 {
+		t.Fatalf("got %d tasks for proj-1, want 2", len(tasks))
+	}
+}
+
+func TestWhereWithNoConditions(t *testing.T) {
+	where, args := NewWhere().Eq("status", "").SQL()
+	if where != "" || args != nil {
+		t.Fatalf("expected empty where clause, got %q %v", where, args)
+	}
+}
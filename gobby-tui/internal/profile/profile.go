@@ -0,0 +1,144 @@
+// Package profile persists per-project UI state (active view, kanban column
+// overrides, filters, selection, chat history) across restarts, following
+// the profile-map pattern from ficsit-cli: profiles keyed by name, a
+// selected profile, versioned JSON on disk.
+package profile
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// currentVersion guards the on-disk schema so future fields can migrate
+// instead of silently losing older profiles.
+const currentVersion = 1
+
+// KanbanColumnOverride mirrors tui.KanbanColumn's shape without importing
+// the tui package (which itself will import profile), so a saved profile
+// can carry a per-profile column layout; callers convert to/from their own
+// column type at the boundary.
+type KanbanColumnOverride struct {
+	Title    string   `json:"title"`
+	Statuses []string `json:"statuses"`
+	Color    string   `json:"color,omitempty"`
+	WIPLimit int      `json:"wip_limit,omitempty"`
+}
+
+// Profile is one saved UI snapshot, normally one per project: the view that
+// was on screen, an optional kanban column override, the active task
+// filter, the last-selected task, and the conversation chat was last
+// showing.
+type Profile struct {
+	ProjectID      string                 `json:"project_id"`
+	ActiveView     string                 `json:"active_view,omitempty"`
+	KanbanColumns  []KanbanColumnOverride `json:"kanban_columns,omitempty"`
+	FilterString   string                 `json:"filter_string,omitempty"`
+	SelectedTaskID string                 `json:"selected_task_id,omitempty"`
+	ConversationID string                 `json:"conversation_id,omitempty"`
+}
+
+// storeFile is the on-disk JSON layout.
+type storeFile struct {
+	Version  int                `json:"version"`
+	Profiles map[string]Profile `json:"profiles"`
+	Selected string             `json:"selected,omitempty"`
+}
+
+// Store manages on-disk profiles keyed by name; today that key is always a
+// ProjectID, with room to grow into named profiles (e.g. "triage" vs
+// "sprint") per project later. Get/Set/Save are safe to call concurrently,
+// since MainModel invokes Set/Save from inside a tea.Cmd closure and
+// bubbletea may run cmds concurrently with each other.
+type Store struct {
+	path     string
+	Profiles map[string]Profile
+	Selected string
+
+	mu sync.Mutex
+}
+
+func defaultPath() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".gobby", "profiles.json"), nil
+}
+
+// Load reads the profile store from ~/.gobby/profiles.json, returning an
+// empty Store if the file doesn't exist or fails to parse.
+func Load() *Store {
+	s := &Store{Profiles: make(map[string]Profile)}
+
+	path, err := defaultPath()
+	if err != nil {
+		return s
+	}
+	s.path = path
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return s
+	}
+
+	var raw storeFile
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return s
+	}
+	if raw.Profiles != nil {
+		s.Profiles = raw.Profiles
+	}
+	s.Selected = raw.Selected
+	return s
+}
+
+// Get returns the saved profile for name, or ok=false if none is saved yet.
+func (s *Store) Get(name string) (Profile, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	p, ok := s.Profiles[name]
+	return p, ok
+}
+
+// Set saves (or replaces) the profile under name and marks it selected.
+func (s *Store) Set(name string, p Profile) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.Profiles == nil {
+		s.Profiles = make(map[string]Profile)
+	}
+	s.Profiles[name] = p
+	s.Selected = name
+}
+
+// Save writes the store to ~/.gobby/profiles.json, creating ~/.gobby if
+// needed.
+func (s *Store) Save() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	path := s.path
+	if path == "" {
+		p, err := defaultPath()
+		if err != nil {
+			return err
+		}
+		path = p
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	raw := storeFile{
+		Version:  currentVersion,
+		Profiles: s.Profiles,
+		Selected: s.Selected,
+	}
+	data, err := json.MarshalIndent(raw, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o644)
+}
@@ -0,0 +1,38 @@
+package profile
+
+import (
+	"path/filepath"
+	"sync"
+	"testing"
+)
+
+// TestStoreConcurrentSetAndSave exercises Set and Save from overlapping
+// goroutines, mirroring how MainModel calls them from separate tea.Cmd
+// closures that bubbletea may run concurrently. Run with -race: before the
+// mutex added in Store, this triggered a concurrent map write.
+func TestStoreConcurrentSetAndSave(t *testing.T) {
+	s := &Store{
+		path:     filepath.Join(t.TempDir(), "profiles.json"),
+		Profiles: make(map[string]Profile),
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(2)
+		go func(i int) {
+			defer wg.Done()
+			s.Set("project", Profile{ActiveView: "kanban"})
+		}(i)
+		go func(i int) {
+			defer wg.Done()
+			if err := s.Save(); err != nil {
+				t.Errorf("Save: %v", err)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	if _, ok := s.Get("project"); !ok {
+		t.Fatal("expected project profile to be saved")
+	}
+}
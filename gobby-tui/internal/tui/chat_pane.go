@@ -1,28 +1,63 @@
 package tui
 
 import (
+	"context"
 	"fmt"
-	"gobby-tui/internal/client"
 	"strings"
 
+	"gobby-tui/internal/adapter"
+	"gobby-tui/internal/client"
+	"gobby-tui/pkg/agents"
+
 	"github.com/charmbracelet/bubbles/textarea"
 	"github.com/charmbracelet/bubbles/viewport"
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/glamour"
 	"github.com/charmbracelet/lipgloss"
 )
 
+// ChatPane is a persisted, branching conversation view. Messages are stored
+// in client.GobbyClient.Conversations as a tree; the pane renders the
+// "active path" from root to the selected leaf, and lets the user edit a
+// prior message to fork a new branch rather than losing history.
 type ChatPane struct {
 	client    *client.GobbyClient
+	systems   *adapter.Aggregator
+	agent     agents.Agent
 	viewport  viewport.Model
 	textarea  textarea.Model
-	messages  []string
+	renderer  *glamour.TermRenderer
 	sessionID string
-	width     int
-	height    int
-	err       error
+
+	conversationID string
+	messages       []client.Message  // every message in the conversation, any branch
+	branchChoice   map[string]string // parentID -> chosen child messageID
+	activePath     []client.Message
+	mode           string // "input" or "select"
+	selected       int    // index into activePath, used in "select" mode
+	editingID      string // message being edited/re-prompted, if any
+
+	streaming      bool
+	streamEvents   <-chan adapter.ChatEvent
+	streamCancel   func()
+	streamCtxStop  context.CancelFunc
+	streamPrompt   string
+	streamParentID string
+	partial        string
+	toolTrace      []string
+
+	width  int
+	height int
+	err    error
 }
 
-func NewChatPane(c *client.GobbyClient) ChatPane {
+// SetAgent restricts which tools this chat session may advertise to the
+// daemon. The zero Agent imposes no restriction.
+func (m *ChatPane) SetAgent(a agents.Agent) {
+	m.agent = a
+}
+
+func NewChatPane(c *client.GobbyClient, systems *adapter.Aggregator) ChatPane {
 	ta := textarea.New()
 	ta.Placeholder = "Ask about your tasks..."
 	ta.Focus()
@@ -36,23 +71,47 @@ func NewChatPane(c *client.GobbyClient) ChatPane {
 	vp := viewport.New(30, 5)
 	vp.SetContent("Welcome to Gobby Chat!\nInitializing session...")
 
+	renderer, _ := glamour.NewTermRenderer(glamour.WithAutoStyle())
+
 	return ChatPane{
-		client:   c,
-		textarea: ta,
-		viewport: vp,
-		messages: []string{},
+		client:       c,
+		systems:      systems,
+		textarea:     ta,
+		viewport:     vp,
+		renderer:     renderer,
+		branchChoice: map[string]string{},
+		mode:         "input",
 	}
 }
 
 type sessionMsg string
-type chatResponseMsg string
 type chatErrorMsg error
+type conversationLoadedMsg struct {
+	conversationID string
+	messages       []client.Message
+}
+type chatStreamEventMsg struct {
+	event adapter.ChatEvent
+	ok    bool
+}
+
+// waitForChatEvent reads exactly one event off the stream and returns it as
+// a tea.Msg; ChatPane.Update re-issues this same command after handling a
+// non-terminal event, so the read loop advances one chunk per Update call.
+func waitForChatEvent(events <-chan adapter.ChatEvent) tea.Cmd {
+	return func() tea.Msg {
+		event, ok := <-events
+		return chatStreamEventMsg{event: event, ok: ok}
+	}
+}
 
 func (m ChatPane) Init() tea.Cmd {
 	return tea.Batch(
 		textarea.Blink,
 		func() tea.Msg {
-			sessions, err := m.client.ListSessions()
+			ctx, cancel := context.WithTimeout(context.Background(), m.client.Config.ListTimeout)
+			defer cancel()
+			sessions, err := m.client.ListSessions(ctx)
 			if err != nil {
 				return chatErrorMsg(err)
 			}
@@ -64,9 +123,53 @@ func (m ChatPane) Init() tea.Cmd {
 			}
 			return chatErrorMsg(fmt.Errorf("no active sessions found"))
 		},
+		m.loadConversation,
 	)
 }
 
+// LoadConversationByID switches this pane to an already-saved conversation,
+// e.g. one picked from ConversationPane.
+func (m ChatPane) LoadConversationByID(id string) tea.Cmd {
+	return func() tea.Msg {
+		if m.client.Conversations == nil {
+			return nil
+		}
+		messages, err := m.client.Conversations.ListMessages(id)
+		if err != nil {
+			return chatErrorMsg(err)
+		}
+		return conversationLoadedMsg{conversationID: id, messages: messages}
+	}
+}
+
+// loadConversation resumes the most recent saved conversation for the
+// current project, or starts a new one if none exists yet.
+func (m ChatPane) loadConversation() tea.Msg {
+	if m.client.Conversations == nil {
+		return nil
+	}
+	convos, err := m.client.Conversations.ListConversations(m.client.ProjectID)
+	if err != nil {
+		return chatErrorMsg(err)
+	}
+
+	var conv client.Conversation
+	if len(convos) > 0 {
+		conv = convos[0]
+	} else {
+		conv, err = m.client.Conversations.CreateConversation(m.client.ProjectID, "New conversation")
+		if err != nil {
+			return chatErrorMsg(err)
+		}
+	}
+
+	messages, err := m.client.Conversations.ListMessages(conv.ID)
+	if err != nil {
+		return chatErrorMsg(err)
+	}
+	return conversationLoadedMsg{conversationID: conv.ID, messages: messages}
+}
+
 func (m ChatPane) Update(msg tea.Msg) (ChatPane, tea.Cmd) {
 	var (
 		tiCmd tea.Cmd
@@ -74,28 +177,19 @@ func (m ChatPane) Update(msg tea.Msg) (ChatPane, tea.Cmd) {
 		cmds  []tea.Cmd
 	)
 
-	m.textarea, tiCmd = m.textarea.Update(msg)
-	cmds = append(cmds, tiCmd)
-	m.viewport, vpCmd = m.viewport.Update(msg)
-	cmds = append(cmds, vpCmd)
-
 	switch msg := msg.(type) {
 	case sessionMsg:
 		m.sessionID = string(msg)
-		m.viewport.SetContent("Connected to session: " + m.sessionID[:8] + "...\nAsk me to manage your tasks.")
 
-	case chatResponseMsg:
-		m.messages = append(m.messages, "Agent: "+string(msg))
-		m.viewport.SetContent(strings.Join(m.messages, "\n"))
-		m.viewport.GotoBottom()
-		m.textarea.Reset()
-		m.textarea.Placeholder = "Ask about your tasks..."
-		m.textarea.Focus()
+	case conversationLoadedMsg:
+		m.conversationID = msg.conversationID
+		m.messages = msg.messages
+		m.rebuildActivePath()
+		m.renderActivePath()
 
 	case chatErrorMsg:
-		m.messages = append(m.messages, fmt.Sprintf("Error: %v", msg))
-		m.viewport.SetContent(strings.Join(m.messages, "\n"))
-		m.viewport.GotoBottom()
+		m.err = msg
+		m.renderActivePath()
 
 	case tea.WindowSizeMsg:
 		m.width = msg.Width
@@ -106,41 +200,278 @@ func (m ChatPane) Update(msg tea.Msg) (ChatPane, tea.Cmd) {
 		m.textarea.SetWidth(m.width)
 
 	case tea.KeyMsg:
+		if m.mode == "select" {
+			switch msg.String() {
+			case "esc", "i":
+				m.mode = "input"
+				m.textarea.Focus()
+				return m, textarea.Blink
+			case "up", "k":
+				if m.selected > 0 {
+					m.selected--
+				}
+			case "down", "j":
+				if m.selected < len(m.activePath)-1 {
+					m.selected++
+				}
+			case "[", "]":
+				m.cycleSibling(msg.String() == "]")
+				m.renderActivePath()
+			case "e":
+				if m.selected >= 0 && m.selected < len(m.activePath) {
+					sel := m.activePath[m.selected]
+					if sel.Role == "user" {
+						m.editingID = sel.ID
+						m.textarea.SetValue(sel.Content)
+						m.mode = "input"
+						m.textarea.Focus()
+						return m, textarea.Blink
+					}
+				}
+			}
+			m.renderActivePath()
+			return m, nil
+		}
+
 		switch msg.Type {
+		case tea.KeyEsc:
+			m.mode = "select"
+			if m.selected >= len(m.activePath) {
+				m.selected = len(m.activePath) - 1
+			}
+			m.textarea.Blur()
+			return m, nil
 		case tea.KeyEnter:
-			if m.textarea.Value() != "" {
+			if m.textarea.Value() != "" && !m.streaming {
 				userMsg := m.textarea.Value()
-				m.messages = append(m.messages, "You: "+userMsg)
-				m.viewport.SetContent(strings.Join(m.messages, "\n"))
-				m.viewport.GotoBottom()
-
-				// Clear input immediately, but disable until reply?
-				// For now, keep it simple.
 				m.textarea.Reset()
 
-				// Async chat call
-				if m.sessionID != "" {
-					cmds = append(cmds, func() tea.Msg {
-						resp, err := m.client.Chat(userMsg, m.sessionID)
-						if err != nil {
-							return chatErrorMsg(err)
-						}
-						return chatResponseMsg(resp)
-					})
-				} else {
-					m.messages = append(m.messages, "Error: No session ID")
+				// The new turn always forks from the parent of the message
+				// being edited (or the current leaf) so re-prompting an
+				// earlier message creates a sibling branch instead of
+				// overwriting history.
+				m.streamParentID = m.parentForNewTurn()
+				m.editingID = ""
+
+				if m.sessionID == "" {
+					m.err = fmt.Errorf("no session ID")
+					break
+				}
+
+				ctx, ctxCancel := context.WithTimeout(context.Background(), m.client.Config.ChatTimeout)
+				events, streamCancel, err := m.systems.ChatStream(ctx, adapter.GobbySystemID, userMsg, m.sessionID, m.agent.AllowedTools)
+				if err != nil {
+					ctxCancel()
+					m.err = err
+					break
 				}
+				m.streaming = true
+				m.streamPrompt = userMsg
+				m.streamEvents = events
+				m.streamCancel = streamCancel
+				m.streamCtxStop = ctxCancel
+				m.partial = ""
+				m.toolTrace = nil
+				m.renderActivePath()
+				return m, waitForChatEvent(events)
+			}
+		case tea.KeyCtrlX:
+			if m.streaming && m.streamCancel != nil {
+				m.streamCancel()
+			}
+			if m.streaming && m.streamCtxStop != nil {
+				m.streamCtxStop()
+			}
+		}
+
+	case chatStreamEventMsg:
+		if !msg.ok {
+			m.streaming = false
+			if m.streamCtxStop != nil {
+				m.streamCtxStop()
 			}
+			break
 		}
+		switch msg.event.Type {
+		case adapter.ChatEventToken:
+			m.partial += msg.event.Data
+			m.renderActivePath()
+			return m, waitForChatEvent(m.streamEvents)
+		case adapter.ChatEventToolCall:
+			m.toolTrace = append(m.toolTrace, "→ calling "+msg.event.Data)
+			m.renderActivePath()
+			return m, waitForChatEvent(m.streamEvents)
+		case adapter.ChatEventToolResult:
+			m.toolTrace = append(m.toolTrace, "← "+msg.event.Data)
+			m.renderActivePath()
+			return m, waitForChatEvent(m.streamEvents)
+		case adapter.ChatEventError:
+			m.streaming = false
+			if m.streamCtxStop != nil {
+				m.streamCtxStop()
+			}
+			m.err = fmt.Errorf("%s", msg.event.Data)
+		case adapter.ChatEventDone:
+			m.streaming = false
+			if m.streamCtxStop != nil {
+				m.streamCtxStop()
+			}
+			if m.client.Conversations != nil && m.conversationID != "" {
+				userMsg, err := m.client.Conversations.AppendMessage(m.conversationID, m.streamParentID, "user", m.streamPrompt, 0)
+				if err == nil {
+					assistantMsg, err := m.client.Conversations.AppendMessage(m.conversationID, userMsg.ID, "assistant", m.partial, 0)
+					if err == nil {
+						m.messages = append(m.messages, userMsg, assistantMsg)
+					}
+				}
+			}
+			m.partial = ""
+			m.toolTrace = nil
+			m.rebuildActivePath()
+		}
+		m.renderActivePath()
 	}
 
+	m.textarea, tiCmd = m.textarea.Update(msg)
+	cmds = append(cmds, tiCmd)
+	m.viewport, vpCmd = m.viewport.Update(msg)
+	cmds = append(cmds, vpCmd)
+
 	return m, tea.Batch(cmds...)
 }
 
+// parentForNewTurn returns the message ID a freshly submitted prompt should
+// be attached under: the selected message's parent when editing, otherwise
+// the current leaf of the active path.
+func (m ChatPane) parentForNewTurn() string {
+	if m.editingID != "" {
+		for _, msg := range m.messages {
+			if msg.ID == m.editingID {
+				return msg.ParentID
+			}
+		}
+	}
+	if len(m.activePath) == 0 {
+		return ""
+	}
+	return m.activePath[len(m.activePath)-1].ID
+}
+
+// cycleSibling moves the branch choice at the selected node's parent to the
+// next (or previous) sibling, then re-derives the active path from there.
+func (m *ChatPane) cycleSibling(forward bool) {
+	if m.selected < 0 || m.selected >= len(m.activePath) {
+		return
+	}
+	node := m.activePath[m.selected]
+	siblings := m.childrenOf(node.ParentID)
+	if len(siblings) < 2 {
+		return
+	}
+	idx := 0
+	for i, s := range siblings {
+		if s.ID == node.ID {
+			idx = i
+			break
+		}
+	}
+	if forward {
+		idx = (idx + 1) % len(siblings)
+	} else {
+		idx = (idx - 1 + len(siblings)) % len(siblings)
+	}
+	m.branchChoice[node.ParentID] = siblings[idx].ID
+	m.rebuildActivePath()
+	if m.selected >= len(m.activePath) {
+		m.selected = len(m.activePath) - 1
+	}
+}
+
+func (m ChatPane) childrenOf(parentID string) []client.Message {
+	var out []client.Message
+	for _, msg := range m.messages {
+		if msg.ParentID == parentID {
+			out = append(out, msg)
+		}
+	}
+	return out
+}
+
+// rebuildActivePath walks from the tree root to a leaf, following
+// m.branchChoice where set and otherwise taking the most recent child.
+func (m *ChatPane) rebuildActivePath() {
+	var path []client.Message
+	parentID := ""
+	for {
+		children := m.childrenOf(parentID)
+		if len(children) == 0 {
+			break
+		}
+		next := children[len(children)-1]
+		if chosen, ok := m.branchChoice[parentID]; ok {
+			for _, c := range children {
+				if c.ID == chosen {
+					next = c
+					break
+				}
+			}
+		}
+		path = append(path, next)
+		parentID = next.ID
+	}
+	m.activePath = path
+	if m.selected >= len(path) {
+		m.selected = len(path) - 1
+	}
+}
+
+func (m *ChatPane) renderActivePath() {
+	var b strings.Builder
+	if len(m.activePath) == 0 {
+		b.WriteString("Ask me to manage your tasks.\n")
+	}
+	for i, msg := range m.activePath {
+		speaker := "You"
+		if msg.Role == "assistant" {
+			speaker = "Agent"
+		}
+		cursor := "  "
+		if m.mode == "select" && i == m.selected {
+			cursor = "> "
+		}
+		content := msg.Content
+		if m.renderer != nil {
+			if rendered, err := m.renderer.Render(content); err == nil {
+				content = strings.TrimRight(rendered, "\n")
+			}
+		}
+		fmt.Fprintf(&b, "%s%s: %s\n", cursor, speaker, content)
+	}
+	if m.streaming {
+		fmt.Fprintf(&b, "  You: %s\n", m.streamPrompt)
+		for _, trace := range m.toolTrace {
+			fmt.Fprintf(&b, "  %s\n", SubtextStyle.Render(trace))
+		}
+		fmt.Fprintf(&b, "  Agent: %s▋\n", m.partial)
+	}
+	if m.err != nil {
+		fmt.Fprintf(&b, "\nError: %v\n", m.err)
+	}
+	m.viewport.SetContent(b.String())
+	m.viewport.GotoBottom()
+}
+
 func (m ChatPane) View() string {
+	hint := "[Esc] select messages  [e] edit & branch  [[ / ]] switch branch"
+	if m.streaming {
+		hint = "[Ctrl+x] cancel response"
+	} else if m.mode == "select" {
+		hint = "[i/Esc] back to input  [e] edit & branch  [[ / ]] switch branch"
+	}
 	return fmt.Sprintf(
-		"%s\n\n%s",
+		"%s\n%s\n\n%s",
 		m.viewport.View(),
+		SubtextStyle.Render(hint),
 		m.textarea.View(),
 	)
 }
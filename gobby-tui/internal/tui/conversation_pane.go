@@ -0,0 +1,156 @@
+package tui
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"gobby-tui/internal/client"
+
+	"github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// ConversationPane lists saved conversations for the current project and
+// lets the user open, rename, or delete them.
+type ConversationPane struct {
+	client   *client.GobbyClient
+	list     list.Model
+	renaming bool
+	input    textinput.Model
+	err      error
+}
+
+type conversationItem struct {
+	conv client.Conversation
+}
+
+func (i conversationItem) Title() string       { return i.conv.Title }
+func (i conversationItem) Description() string { return i.conv.CreatedAt }
+func (i conversationItem) FilterValue() string { return i.conv.Title }
+
+type conversationDelegate struct{}
+
+func (d conversationDelegate) Height() int                             { return 2 }
+func (d conversationDelegate) Spacing() int                            { return 1 }
+func (d conversationDelegate) Update(_ tea.Msg, _ *list.Model) tea.Cmd { return nil }
+func (d conversationDelegate) Render(w io.Writer, m list.Model, index int, listItem list.Item) {
+	i, ok := listItem.(conversationItem)
+	if !ok {
+		return
+	}
+	str := fmt.Sprintf("%s\n%s", i.conv.Title, i.conv.CreatedAt)
+	fn := itemStyle.Render
+	if index == m.Index() {
+		fn = func(s ...string) string {
+			return selectedItemStyle.Render("> " + strings.Join(s, " "))
+		}
+	}
+	fmt.Fprint(w, fn(str))
+}
+
+func NewConversationPane(c *client.GobbyClient) ConversationPane {
+	l := list.New([]list.Item{}, conversationDelegate{}, 30, 14)
+	l.Title = "Conversations"
+	l.SetShowStatusBar(false)
+	l.Styles.Title = SplashTitleStyle
+
+	ti := textinput.New()
+	ti.Placeholder = "New title..."
+
+	return ConversationPane{client: c, list: l, input: ti}
+}
+
+type conversationsLoadedMsg []client.Conversation
+type ConversationSelectedMsg struct {
+	ConversationID string
+}
+
+func (m ConversationPane) Init() tea.Cmd {
+	return m.fetchConversations
+}
+
+func (m ConversationPane) fetchConversations() tea.Msg {
+	if m.client.Conversations == nil {
+		return nil
+	}
+	convos, err := m.client.Conversations.ListConversations(m.client.ProjectID)
+	if err != nil {
+		return chatErrorMsg(err)
+	}
+	return conversationsLoadedMsg(convos)
+}
+
+func (m ConversationPane) Update(msg tea.Msg) (ConversationPane, tea.Cmd) {
+	var cmd tea.Cmd
+
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.list.SetWidth(msg.Width)
+		m.list.SetHeight(msg.Height - 4)
+
+	case conversationsLoadedMsg:
+		items := make([]list.Item, len(msg))
+		for i, c := range msg {
+			items[i] = conversationItem{conv: c}
+		}
+		m.list.SetItems(items)
+
+	case chatErrorMsg:
+		m.err = msg
+
+	case tea.KeyMsg:
+		if m.renaming {
+			switch msg.String() {
+			case "enter":
+				sel, ok := m.list.SelectedItem().(conversationItem)
+				m.renaming = false
+				if ok && m.client.Conversations != nil {
+					title := m.input.Value()
+					m.client.Conversations.RenameConversation(sel.conv.ID, title)
+					return m, m.fetchConversations
+				}
+			case "esc":
+				m.renaming = false
+			}
+			m.input, cmd = m.input.Update(msg)
+			return m, cmd
+		}
+
+		switch msg.String() {
+		case "r":
+			if sel, ok := m.list.SelectedItem().(conversationItem); ok {
+				m.renaming = true
+				m.input.SetValue(sel.conv.Title)
+				m.input.Focus()
+				return m, textinput.Blink
+			}
+		case "d":
+			if sel, ok := m.list.SelectedItem().(conversationItem); ok && m.client.Conversations != nil {
+				m.client.Conversations.DeleteConversation(sel.conv.ID)
+				return m, m.fetchConversations
+			}
+		case "enter":
+			if sel, ok := m.list.SelectedItem().(conversationItem); ok {
+				return m, func() tea.Msg {
+					return ConversationSelectedMsg{ConversationID: sel.conv.ID}
+				}
+			}
+		}
+	}
+
+	m.list, cmd = m.list.Update(msg)
+	return m, cmd
+}
+
+func (m ConversationPane) View() string {
+	view := BaseStyle.Render(m.list.View())
+	if m.renaming {
+		view += "\n" + m.input.View()
+	}
+	if m.err != nil {
+		view += "\n" + fmt.Sprintf("Error: %v", m.err)
+	}
+	return view
+}
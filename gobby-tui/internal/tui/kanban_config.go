@@ -0,0 +1,67 @@
+package tui
+
+import (
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v2"
+)
+
+// KanbanColumn is one ordered column on the kanban board: a display Title,
+// the task Statuses it collects (a task lands in the first column whose
+// Statuses contains its status), and an optional Color and WIPLimit. A
+// WIPLimit of 0 means unlimited.
+type KanbanColumn struct {
+	Title    string   `yaml:"title"`
+	Statuses []string `yaml:"statuses"`
+	Color    string   `yaml:"color"`
+	WIPLimit int      `yaml:"wip_limit"`
+}
+
+// KanbanConfig is the ordered set of columns the kanban board renders.
+type KanbanConfig struct {
+	Columns []KanbanColumn
+}
+
+// DefaultKanbanConfig reproduces the board's original four columns, used
+// when no config file is present or it has no "kanban" section.
+func DefaultKanbanConfig() KanbanConfig {
+	return KanbanConfig{
+		Columns: []KanbanColumn{
+			{Title: "Open", Statuses: []string{"open", "todo"}},
+			{Title: "In Progress", Statuses: []string{"in_progress"}},
+			{Title: "Review", Statuses: []string{"review"}},
+			{Title: "Closed", Statuses: []string{"closed", "done"}},
+		},
+	}
+}
+
+type kanbanConfigFile struct {
+	Kanban struct {
+		Columns []KanbanColumn `yaml:"columns"`
+	} `yaml:"kanban"`
+}
+
+// LoadKanbanConfig reads the "kanban" section of ~/.gobby/gobby-tui.yaml,
+// falling back to DefaultKanbanConfig if the file or section is absent.
+func LoadKanbanConfig() KanbanConfig {
+	cfg := DefaultKanbanConfig()
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return cfg
+	}
+	data, err := os.ReadFile(filepath.Join(home, ".gobby", "gobby-tui.yaml"))
+	if err != nil {
+		return cfg
+	}
+
+	var raw kanbanConfigFile
+	if err := yaml.Unmarshal(data, &raw); err != nil {
+		return cfg
+	}
+	if len(raw.Kanban.Columns) > 0 {
+		cfg.Columns = raw.Kanban.Columns
+	}
+	return cfg
+}
@@ -1,9 +1,13 @@
 package tui
 
 import (
+	"context"
 	"fmt"
-	"gobby-tui/internal/client"
 	"strings"
+	"time"
+
+	"gobby-tui/internal/adapter"
+	"gobby-tui/internal/client"
 
 	"github.com/charmbracelet/bubbles/list"
 	tea "github.com/charmbracelet/bubbletea"
@@ -12,16 +16,28 @@ import (
 
 type KanbanPane struct {
 	client  *client.GobbyClient
+	systems *adapter.Aggregator
+
+	cfg     KanbanConfig
 	cols    []list.Model
 	focused int
 	width   int
 	height  int
-	tasks   []client.Task
+	tasks   []adapter.Task
+
+	// pendingStatus/pendingDelete hold what an in-flight optimistic move or
+	// delete needs to roll back to if the daemon round-trip fails.
+	pendingStatus map[string]string
+	pendingDelete map[string]adapter.Task
+
+	// statusMsg replaces the pane's help line with an explanation (e.g. a
+	// WIP-limit warning) until the next key press clears it.
+	statusMsg string
 }
 
 // Implement list.Item interface
 type kanbanItem struct {
-	task client.Task
+	task adapter.Task
 }
 
 func (i kanbanItem) Title() string { return i.task.Title }
@@ -31,22 +47,51 @@ func (i kanbanItem) Description() string {
 }
 func (i kanbanItem) FilterValue() string { return i.task.Title }
 
-func NewKanbanPane(c *client.GobbyClient) KanbanPane {
-	// Initialize 4 columns: Open, In Progress, Review, Closed
-	cols := make([]list.Model, 4)
-	titles := []string{"Open", "In Progress", "Review", "Closed"}
+// kanbanConfirmedDeleteMsg carries a user-confirmed delete back from the
+// ConfirmDialog overlay's onConfirm cmd; a tea.Cmd closure can't mutate m
+// directly, so it reports the decision as a message instead.
+type kanbanConfirmedDeleteMsg struct {
+	task adapter.Task
+}
 
-	for i := range cols {
+func NewKanbanPane(c *client.GobbyClient, systems *adapter.Aggregator) KanbanPane {
+	cfg := LoadKanbanConfig()
+	cols := make([]list.Model, len(cfg.Columns))
+	for i, colCfg := range cfg.Columns {
 		cols[i] = list.New([]list.Item{}, list.NewDefaultDelegate(), 30, 20)
-		cols[i].Title = titles[i]
+		cols[i].Title = colCfg.Title
 		cols[i].SetShowHelp(false)
 	}
 
 	return KanbanPane{
-		client:  c,
-		cols:    cols,
-		focused: 0,
+		client:        c,
+		systems:       systems,
+		cfg:           cfg,
+		cols:          cols,
+		focused:       0,
+		pendingStatus: make(map[string]string),
+		pendingDelete: make(map[string]adapter.Task),
+	}
+}
+
+// SetColumns replaces the board's column configuration (e.g. a profile's
+// saved override) and rebuilds the underlying lists, redistributing any
+// tasks already loaded.
+func (m *KanbanPane) SetColumns(cols []KanbanColumn) {
+	m.cfg.Columns = cols
+	m.cols = make([]list.Model, len(cols))
+	for i, colCfg := range cols {
+		m.cols[i] = list.New([]list.Item{}, list.NewDefaultDelegate(), 30, 20)
+		m.cols[i].Title = colCfg.Title
+		m.cols[i].SetShowHelp(false)
+		if m.width > 0 {
+			m.cols[i].SetSize(m.width/len(cols)-2, m.height-5)
+		}
+	}
+	if m.focused >= len(m.cols) {
+		m.focused = 0
 	}
+	m.distributeTasks()
 }
 
 func (m KanbanPane) Init() tea.Cmd {
@@ -58,20 +103,90 @@ func (m KanbanPane) Update(msg tea.Msg) (KanbanPane, tea.Cmd) {
 	var cmds []tea.Cmd
 
 	switch msg := msg.(type) {
-	case []client.Task:
+	case []adapter.Task:
 		// Reload columns
 		m.tasks = msg
 		m.distributeTasks()
 
+	case TaskUpdatedMsg:
+		if msg.Err != nil {
+			if orig, ok := m.pendingStatus[msg.ID]; ok {
+				for i, t := range m.tasks {
+					if t.ID == msg.ID {
+						m.tasks[i].Status = orig
+						break
+					}
+				}
+				m.distributeTasks()
+			}
+		}
+		delete(m.pendingStatus, msg.ID)
+		if msg.Err != nil {
+			return m, ShowToast(fmt.Sprintf("Move failed: %v", msg.Err))
+		}
+		return m, nil
+
+	case TaskDeletedMsg:
+		if msg.Err != nil {
+			if t, ok := m.pendingDelete[msg.ID]; ok {
+				m.tasks = append(m.tasks, t)
+				m.distributeTasks()
+			}
+		}
+		delete(m.pendingDelete, msg.ID)
+		if msg.Err != nil {
+			return m, ShowToast(fmt.Sprintf("Delete failed: %v", msg.Err))
+		}
+		return m, nil
+
+	case kanbanConfirmedDeleteMsg:
+		m.pendingDelete[msg.task.ID] = msg.task
+		var newTasks []adapter.Task
+		for _, t := range m.tasks {
+			if t.ID != msg.task.ID {
+				newTasks = append(newTasks, t)
+			}
+		}
+		m.tasks = newTasks
+		m.distributeTasks()
+		return m, deleteTaskCmd(m.systems, m.client.Config.ListTimeout, msg.task.SystemID, msg.task.ID)
+
+	case TaskFormSubmittedMsg:
+		return m.applyFormSubmission(msg)
+
+	case taskMutatedMsg:
+		return m.applyMutationResult(msg)
+
+	case JumpToTaskMsg:
+		for ci, col := range m.cols {
+			for ii, it := range col.Items() {
+				if k, ok := it.(kanbanItem); ok && k.task.ID == msg.Task.ID {
+					m.focused = ci
+					m.cols[ci].Select(ii)
+					return m, nil
+				}
+			}
+		}
+		return m, nil
+
+	case TaskLiveChangedMsg:
+		m.applyLiveChange(msg.Task)
+		return m, nil
+
+	case TaskLiveDeletedMsg:
+		m.applyLiveDelete(msg.ID)
+		return m, nil
+
 	case tea.WindowSizeMsg:
 		m.width = msg.Width
 		m.height = msg.Height
-		colWidth := m.width / 4
+		colWidth := m.width / len(m.cols)
 		for i := range m.cols {
 			m.cols[i].SetSize(colWidth-2, m.height-5)
 		}
 
 	case tea.KeyMsg:
+		m.statusMsg = ""
 		switch msg.String() {
 		case "h", "left":
 			m.focused--
@@ -85,16 +200,17 @@ func (m KanbanPane) Update(msg tea.Msg) (KanbanPane, tea.Cmd) {
 			}
 		case "H": // Move Task Left
 			if m.focused > 0 {
+				target := m.focused - 1
 				selectedItem := m.cols[m.focused].SelectedItem()
-				if selectedItem != nil {
+				if selectedItem != nil && m.columnAtLimit(target) {
+					m.statusMsg = m.wipLimitMessage(target)
+				} else if selectedItem != nil {
 					item := selectedItem.(kanbanItem)
-					newStatus := m.getStatusForColumn(m.focused - 1)
+					newStatus := m.getStatusForColumn(target)
 
-					// Optimistic update
-					item.task.Status = newStatus
-					m.updateTaskStatus(item.task.ID, newStatus)
-
-					// Re-distribute (simplistic, could be optimized)
+					// Optimistic update; pendingStatus remembers the old
+					// status so a failed round-trip can be rolled back.
+					m.pendingStatus[item.task.ID] = item.task.Status
 					for i, t := range m.tasks {
 						if t.ID == item.task.ID {
 							m.tasks[i].Status = newStatus
@@ -102,20 +218,21 @@ func (m KanbanPane) Update(msg tea.Msg) (KanbanPane, tea.Cmd) {
 						}
 					}
 					m.distributeTasks()
-					m.focused--
+					m.focused = target
+					cmds = append(cmds, updateTaskStatusCmd(m.systems, m.client.Config.ListTimeout, item.task.SystemID, item.task.ID, newStatus))
 				}
 			}
 		case "L": // Move Task Right
 			if m.focused < len(m.cols)-1 {
+				target := m.focused + 1
 				selectedItem := m.cols[m.focused].SelectedItem()
-				if selectedItem != nil {
+				if selectedItem != nil && m.columnAtLimit(target) {
+					m.statusMsg = m.wipLimitMessage(target)
+				} else if selectedItem != nil {
 					item := selectedItem.(kanbanItem)
-					newStatus := m.getStatusForColumn(m.focused + 1)
-
-					// Optimistic update
-					item.task.Status = newStatus
-					m.updateTaskStatus(item.task.ID, newStatus)
+					newStatus := m.getStatusForColumn(target)
 
+					m.pendingStatus[item.task.ID] = item.task.Status
 					for i, t := range m.tasks {
 						if t.ID == item.task.ID {
 							m.tasks[i].Status = newStatus
@@ -123,25 +240,24 @@ func (m KanbanPane) Update(msg tea.Msg) (KanbanPane, tea.Cmd) {
 						}
 					}
 					m.distributeTasks()
-					m.focused++
+					m.focused = target
+					cmds = append(cmds, updateTaskStatusCmd(m.systems, m.client.Config.ListTimeout, item.task.SystemID, item.task.ID, newStatus))
 				}
 			}
-		case "d": // Delete
+		case "d": // Delete (after confirmation)
 			selectedItem := m.cols[m.focused].SelectedItem()
 			if selectedItem != nil {
-				item := selectedItem.(kanbanItem)
-				m.deleteTask(item.task.ID)
-
-				// Remove from local list
-				var newTasks []client.Task
-				for _, t := range m.tasks {
-					if t.ID != item.task.ID {
-						newTasks = append(newTasks, t)
-					}
-				}
-				m.tasks = newTasks
-				m.distributeTasks()
+				task := selectedItem.(kanbanItem).task
+				confirm := NewConfirmDialog(
+					fmt.Sprintf("Delete %q?", task.Title),
+					func() tea.Msg { return kanbanConfirmedDeleteMsg{task: task} },
+				)
+				cmds = append(cmds, OpenDialog(confirm, Rect{Width: 40, Height: 6}))
 			}
+		case "n": // New task
+			form := NewTaskFormPane(m.client)
+			form.Reset()
+			cmds = append(cmds, OpenDialog(taskFormWindow{form: form}, Rect{}))
 		}
 	}
 
@@ -151,43 +267,139 @@ func (m KanbanPane) Update(msg tea.Msg) (KanbanPane, tea.Cmd) {
 	return m, tea.Batch(cmds...)
 }
 
+// applyLiveChange upserts a single task pushed by the live update stream,
+// preserving whichever task is currently selected.
+func (m *KanbanPane) applyLiveChange(t adapter.Task) {
+	selectedID, hadSelection := m.selectedTaskID()
+
+	found := false
+	for i, existing := range m.tasks {
+		if existing.ID == t.ID {
+			m.tasks[i] = t
+			found = true
+			break
+		}
+	}
+	if !found {
+		m.tasks = append(m.tasks, t)
+	}
+	m.distributeTasks()
+
+	if hadSelection {
+		m.restoreSelection(selectedID)
+	}
+}
+
+// applyLiveDelete removes a task reported deleted by the live update
+// stream, likewise preserving selection if something else is selected.
+func (m *KanbanPane) applyLiveDelete(id string) {
+	selectedID, hadSelection := m.selectedTaskID()
+
+	var kept []adapter.Task
+	for _, t := range m.tasks {
+		if t.ID != id {
+			kept = append(kept, t)
+		}
+	}
+	m.tasks = kept
+	m.distributeTasks()
+
+	if hadSelection && selectedID != id {
+		m.restoreSelection(selectedID)
+	}
+}
+
+func (m KanbanPane) selectedTaskID() (string, bool) {
+	if item := m.cols[m.focused].SelectedItem(); item != nil {
+		return item.(kanbanItem).task.ID, true
+	}
+	return "", false
+}
+
+func (m *KanbanPane) restoreSelection(id string) {
+	for ci, col := range m.cols {
+		for ii, it := range col.Items() {
+			if k, ok := it.(kanbanItem); ok && k.task.ID == id {
+				m.focused = ci
+				m.cols[ci].Select(ii)
+				return
+			}
+		}
+	}
+}
+
+// getStatusForColumn returns the status a task should carry after moving
+// into colIndex: the first (canonical) entry in that column's Statuses.
 func (m KanbanPane) getStatusForColumn(colIndex int) string {
-	switch colIndex {
-	case 0:
-		return "open"
-	case 1:
-		return "in_progress"
-	case 2:
-		return "review"
-	case 3:
-		return "closed"
-	default:
-		return "open"
+	statuses := m.cfg.Columns[colIndex].Statuses
+	if len(statuses) == 0 {
+		return ""
 	}
+	return statuses[0]
 }
 
-func (m KanbanPane) updateTaskStatus(id, status string) {
-	// Run in background (goroutine) or tea.Cmd?
-	// For now, fire and forget in goroutine to not block UI,
-	// ideally should be a Cmd that returns Msg on success/fail
-	go func() {
-		m.client.UpdateTask(id, status)
-	}()
+// columnForStatus returns the index of the first column whose Statuses
+// contains status, or 0 if none match.
+func (m KanbanPane) columnForStatus(status string) int {
+	for i, col := range m.cfg.Columns {
+		for _, s := range col.Statuses {
+			if strings.EqualFold(s, status) {
+				return i
+			}
+		}
+	}
+	return 0
 }
 
-func (m KanbanPane) deleteTask(id string) {
-	go func() {
-		m.client.DeleteTask(id)
-	}()
+// columnAtLimit reports whether colIndex is at or over its configured
+// WIPLimit (a limit of 0 means unlimited).
+func (m KanbanPane) columnAtLimit(colIndex int) bool {
+	limit := m.cfg.Columns[colIndex].WIPLimit
+	return limit > 0 && len(m.cols[colIndex].Items()) >= limit
+}
+
+func (m KanbanPane) wipLimitMessage(colIndex int) string {
+	col := m.cfg.Columns[colIndex]
+	return fmt.Sprintf("%q is at its WIP limit (%d)", col.Title, col.WIPLimit)
+}
+
+// ApplyStatusChange optimistically updates taskID's status (e.g. chosen from
+// the command palette) and kicks off the same update/rollback pipeline the
+// "H"/"L" column moves use.
+func (m *KanbanPane) ApplyStatusChange(taskID, systemID, newStatus string) tea.Cmd {
+	for i, t := range m.tasks {
+		if t.ID == taskID {
+			m.pendingStatus[taskID] = t.Status
+			m.tasks[i].Status = newStatus
+			break
+		}
+	}
+	m.distributeTasks()
+	return updateTaskStatusCmd(m.systems, m.client.Config.ListTimeout, systemID, taskID, newStatus)
 }
 
 func (m KanbanPane) View() string {
 	var views []string
-	for i, col := range m.cols {
+	for i := range m.cols {
+		col := m.cols[i]
+		colCfg := m.cfg.Columns[i]
+
+		titleColor := ColorText
+		if colCfg.Color != "" {
+			titleColor = lipgloss.Color(colCfg.Color)
+		}
+		title := colCfg.Title
+		if m.columnAtLimit(i) {
+			titleColor = ColorWarning
+			title = fmt.Sprintf("%s (%d/%d)", colCfg.Title, len(col.Items()), colCfg.WIPLimit)
+		}
+		col.Title = title
+		col.Styles.Title = col.Styles.Title.Foreground(titleColor)
+
 		style := lipgloss.NewStyle().
 			Border(lipgloss.RoundedBorder()).
 			Padding(0, 1).
-			Width((m.width / 4) - 2)
+			Width((m.width / len(m.cols)) - 2)
 
 		if i == m.focused {
 			style = style.BorderForeground(ColorPrimary)
@@ -197,28 +409,71 @@ func (m KanbanPane) View() string {
 
 		views = append(views, style.Render(col.View()))
 	}
-	return lipgloss.JoinHorizontal(lipgloss.Top, views...)
+	board := lipgloss.JoinHorizontal(lipgloss.Top, views...)
+	if m.statusMsg != "" {
+		board += "\n" + lipgloss.NewStyle().Foreground(ColorWarning).Render(m.statusMsg)
+	} else {
+		board += "\n" + SubtextStyle.Render("[h/l] focus column  [H/L] move task  [n] new  [d] delete")
+	}
+	return board
 }
 
-func (m *KanbanPane) distributeTasks() {
-	// Clear lists
-	buckets := make([][]list.Item, 4)
+// applyFormSubmission optimistically adds a task created via the kanban "n"
+// overlay before the daemon round-trip completes; applyMutationResult rolls
+// it back if the call fails. Mirrors TaskPane's handling of the same
+// TaskFormSubmittedMsg/taskMutatedMsg pair.
+func (m KanbanPane) applyFormSubmission(msg TaskFormSubmittedMsg) (KanbanPane, tea.Cmd) {
+	if msg.Mode != "create" {
+		// Kanban only opens the form in "create" mode today.
+		return m, nil
+	}
 
+	tempID := fmt.Sprintf("pending-%d", time.Now().UnixNano())
+	m.tasks = append(m.tasks, adapter.Task{
+		ID:       tempID,
+		Title:    msg.Data.Title,
+		Status:   msg.Data.Status,
+		Priority: msg.Data.Priority,
+		SystemID: adapter.GobbySystemID,
+	})
+	m.distributeTasks()
+	return m, submitTaskFormCmd(m.client, msg, tempID, adapter.Task{})
+}
+
+func (m KanbanPane) applyMutationResult(msg taskMutatedMsg) (KanbanPane, tea.Cmd) {
+	if msg.err == nil {
+		// The daemon assigns the real ID/seq_num; refetch to replace the
+		// optimistic placeholder with the authoritative row.
+		systems, timeout := m.systems, m.client.Config.ListTimeout
+		return m, func() tea.Msg {
+			ctx, cancel := context.WithTimeout(context.Background(), timeout)
+			defer cancel()
+			tasks, err := systems.ListTasks(ctx, "")
+			if err != nil {
+				return err
+			}
+			return tasks
+		}
+	}
+
+	var kept []adapter.Task
 	for _, t := range m.tasks {
-		item := kanbanItem{task: t}
-		switch {
-		case strings.Contains(strings.ToLower(t.Status), "open"):
-			buckets[0] = append(buckets[0], item)
-		case strings.Contains(strings.ToLower(t.Status), "progress"):
-			buckets[1] = append(buckets[1], item)
-		case strings.Contains(strings.ToLower(t.Status), "review"):
-			buckets[2] = append(buckets[2], item)
-		case strings.Contains(strings.ToLower(t.Status), "closed"):
-			buckets[3] = append(buckets[3], item)
-		default:
-			buckets[0] = append(buckets[0], item)
+		if t.ID != msg.tempID {
+			kept = append(kept, t)
 		}
 	}
+	m.tasks = kept
+	m.distributeTasks()
+	return m, ShowToast(fmt.Sprintf("Create failed: %v", msg.err))
+}
+
+func (m *KanbanPane) distributeTasks() {
+	buckets := make([][]list.Item, len(m.cfg.Columns))
+
+	for _, t := range m.tasks {
+		idx := m.columnForStatus(t.Status)
+		buckets[idx] = append(buckets[idx], kanbanItem{task: t})
+	}
 
 	for i := range m.cols {
 		m.cols[i].SetItems(buckets[i])
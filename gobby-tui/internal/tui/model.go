@@ -1,43 +1,267 @@
 package tui
 
 import (
+	"context"
+	"time"
+
+	"gobby-tui/internal/adapter"
 	"gobby-tui/internal/client"
+	"gobby-tui/internal/profile"
 
 	tea "github.com/charmbracelet/bubbletea"
 )
 
+// profileSaveDebounce bounds how often the active project's profile is
+// rewritten to disk; rapid view changes (tabbing through every pane) then
+// collapse into one write instead of thrashing ~/.gobby/profiles.json.
+const profileSaveDebounce = 2 * time.Second
+
 type MainModel struct {
-	client      *client.GobbyClient
-	splash      SplashModel
-	taskPane    TaskPane
-	kanbanPane  KanbanPane
-	chatPane    ChatPane
-	projectPane ProjectPane
-	activeView  string // "splash", "list", "kanban", "chat", "projects"
-	quitting    bool
-	width       int
-	height      int
-}
-
-func NewMainModel(c *client.GobbyClient) MainModel {
+	client           *client.GobbyClient
+	systems          *adapter.Aggregator
+	splash           SplashModel
+	taskPane         TaskPane
+	kanbanPane       KanbanPane
+	chatPane         ChatPane
+	projectPane      ProjectPane
+	toolPane         ToolPane
+	conversationPane ConversationPane
+	taskFormPane     TaskFormPane
+	wm               WindowManager
+	activeView       string // "splash", "list", "kanban", "chat", "projects", "tools", "conversations", "taskform"
+	quitting         bool
+	width            int
+	height           int
+	lastUpdate       time.Time
+
+	profiles            *profile.Store
+	lastProfileSave     time.Time
+	pendingSelectTaskID string // set on profile load, consumed once tasks arrive
+
+	taskEvents       <-chan client.TaskEvent // open /tasks/stream connection, if any
+	taskStreamCancel func()                  // closes it
+}
+
+// watchEventMsg wraps a client.WatchEvent so MainModel can distinguish it
+// from other tea.Msg types on the bus.
+type watchEventMsg struct {
+	event client.WatchEvent
+	ok    bool
+}
+
+// TasksChangedMsg, SessionsChangedMsg and ProjectFilesChangedMsg are the
+// typed notifications panes subscribe to so they can refresh without
+// waiting for user input.
+type TasksChangedMsg struct{}
+type SessionsChangedMsg struct{}
+type ProjectFilesChangedMsg struct{}
+
+// TaskLiveChangedMsg and TaskLiveDeletedMsg carry one delta off the daemon's
+// /tasks/stream SSE feed straight to TaskPane/KanbanPane, so a single task
+// edit elsewhere updates the board without a full refetch or disturbing the
+// current selection. Only that feed produces them; a filesystem watcher
+// change triggers a full task refetch (TasksChangedMsg) instead.
+type TaskLiveChangedMsg struct {
+	Task adapter.Task
+}
+type TaskLiveDeletedMsg struct {
+	ID string
+}
+
+// taskStreamConnectedMsg reports a successful dial of the daemon's
+// /tasks/stream endpoint; taskStreamUnavailableMsg reports that it isn't
+// exposed (an older daemon), in which case MainModel relies solely on the
+// filesystem watcher already started in Init.
+type taskStreamConnectedMsg struct {
+	events <-chan client.TaskEvent
+	cancel func()
+}
+type taskStreamUnavailableMsg struct{}
+
+// taskStreamEventMsg wraps one client.TaskEvent read off an already-open
+// task stream.
+type taskStreamEventMsg struct {
+	event client.TaskEvent
+	ok    bool
+}
+
+// connectTaskStream dials the daemon's live task feed. It's only ever
+// issued once, from Init, and reads no events itself; readTaskStream reads
+// every event once the connection is up.
+func connectTaskStream(c *client.GobbyClient) tea.Cmd {
+	return func() tea.Msg {
+		events, cancel, err := c.TaskStream(context.Background())
+		if err != nil {
+			return taskStreamUnavailableMsg{}
+		}
+		return taskStreamConnectedMsg{events: events, cancel: cancel}
+	}
+}
+
+// readTaskStream reads one event off an already-open task stream and
+// reports it; MainModel re-issues this command after each event so the
+// subscription keeps running for the life of the program, mirroring
+// watchFilesystem for the fsnotify channel.
+func readTaskStream(events <-chan client.TaskEvent) tea.Cmd {
+	return func() tea.Msg {
+		event, ok := <-events
+		return taskStreamEventMsg{event: event, ok: ok}
+	}
+}
+
+// toLiveMsg translates a raw client.TaskEvent into the typed message panes
+// reconcile against.
+func toLiveMsg(event client.TaskEvent) tea.Msg {
+	switch event.Type {
+	case client.TaskEventDeleted:
+		return TaskLiveDeletedMsg{ID: event.Task.ID}
+	default:
+		return TaskLiveChangedMsg{Task: adapter.Task{
+			ID:       event.Task.ID,
+			Title:    event.Task.Title,
+			Status:   event.Task.Status,
+			Priority: event.Task.Priority,
+			SeqNum:   event.Task.SeqNum,
+			SystemID: adapter.GobbySystemID,
+		}}
+	}
+}
+
+// watchFilesystem reads one event off the client's watcher and translates
+// it into the matching typed Msg; MainModel re-issues this command after
+// each event so the subscription keeps running for the life of the program.
+func watchFilesystem(events <-chan client.WatchEvent) tea.Cmd {
+	return func() tea.Msg {
+		event, ok := <-events
+		return watchEventMsg{event: event, ok: ok}
+	}
+}
+
+// NewMainModel wires up every pane against the Gobby daemon client plus
+// whatever other TaskSystems the caller registers (GitHub Issues, Linear,
+// ...); today that's just a GobbyAdapter wrapping c, but panes that read or
+// mutate tasks already go through the resulting Aggregator.
+func NewMainModel(c *client.GobbyClient, systems []adapter.TaskSystem) MainModel {
+	agg := adapter.NewAggregator(systems...)
 	return MainModel{
-		client:      c,
-		splash:      NewSplashModel(),
-		taskPane:    NewTaskPane(c),
-		kanbanPane:  NewKanbanPane(c),
-		chatPane:    NewChatPane(c),
-		projectPane: NewProjectPane(c),
-		activeView:  "splash",
+		client:           c,
+		systems:          agg,
+		splash:           NewSplashModel(),
+		taskPane:         NewTaskPane(c, agg),
+		kanbanPane:       NewKanbanPane(c, agg),
+		chatPane:         NewChatPane(c, agg),
+		projectPane:      NewProjectPane(c, agg),
+		toolPane:         NewToolPane(c),
+		conversationPane: NewConversationPane(c),
+		taskFormPane:     NewTaskFormPane(c),
+		activeView:       "splash",
+		profiles:         profile.Load(),
 	}
 }
 
+// currentProfile captures a snapshot of the UI state worth restoring next
+// time this project is opened.
+func (m MainModel) currentProfile() profile.Profile {
+	var selectedTaskID string
+	if t, ok := m.selectedTaskForPalette(); ok {
+		selectedTaskID = t.ID
+	}
+
+	cols := make([]profile.KanbanColumnOverride, len(m.kanbanPane.cfg.Columns))
+	for i, c := range m.kanbanPane.cfg.Columns {
+		cols[i] = profile.KanbanColumnOverride{
+			Title:    c.Title,
+			Statuses: c.Statuses,
+			Color:    c.Color,
+			WIPLimit: c.WIPLimit,
+		}
+	}
+
+	return profile.Profile{
+		ProjectID:      m.client.ProjectID,
+		ActiveView:     m.activeView,
+		KanbanColumns:  cols,
+		FilterString:   m.taskPane.input.Value(),
+		SelectedTaskID: selectedTaskID,
+		ConversationID: m.chatPane.conversationID,
+	}
+}
+
+// applyProfile restores a previously-saved snapshot for the project that
+// was just selected; p's zero value (no saved profile yet) leaves defaults
+// in place.
+func (m *MainModel) applyProfile(p profile.Profile) {
+	if p.ActiveView != "" {
+		m.activeView = p.ActiveView
+	}
+	if len(p.KanbanColumns) > 0 {
+		cols := make([]KanbanColumn, len(p.KanbanColumns))
+		for i, c := range p.KanbanColumns {
+			cols[i] = KanbanColumn{
+				Title:    c.Title,
+				Statuses: c.Statuses,
+				Color:    c.Color,
+				WIPLimit: c.WIPLimit,
+			}
+		}
+		m.kanbanPane.SetColumns(cols)
+	}
+	if p.FilterString != "" {
+		m.taskPane.SetFilter(p.FilterString)
+	}
+	m.pendingSelectTaskID = p.SelectedTaskID
+}
+
+// saveProfileCmd persists the current project's UI snapshot. Debounced
+// saves are skipped if the last write was too recent; force bypasses that
+// (e.g. on quit, where there won't be a "next" change to coalesce with).
+func (m *MainModel) saveProfileCmd(force bool) tea.Cmd {
+	if m.client.ProjectID == "" {
+		return nil
+	}
+	if !force && !m.lastProfileSave.IsZero() && time.Since(m.lastProfileSave) < profileSaveDebounce {
+		return nil
+	}
+	m.lastProfileSave = time.Now()
+
+	store := m.profiles
+	p := m.currentProfile()
+	return func() tea.Msg {
+		store.Set(p.ProjectID, p)
+		store.Save() // best-effort; a failed save just loses this snapshot
+		return nil
+	}
+}
+
+// selectedTaskForPalette returns whichever task is currently highlighted in
+// the list or kanban view, so the palette can offer status transitions for
+// it; neither view being active (or nothing selected) reports ok=false.
+func (m MainModel) selectedTaskForPalette() (adapter.Task, bool) {
+	switch m.activeView {
+	case "list":
+		return m.taskPane.selectedTask()
+	case "kanban":
+		if item := m.kanbanPane.cols[m.kanbanPane.focused].SelectedItem(); item != nil {
+			return item.(kanbanItem).task, true
+		}
+	}
+	return adapter.Task{}, false
+}
+
 func (m MainModel) Init() tea.Cmd {
-	return tea.Batch(
+	cmds := []tea.Cmd{
 		m.splash.Init(),
 		m.taskPane.Init(),
 		m.kanbanPane.Init(),
 		m.chatPane.Init(),
-	)
+		m.toolPane.Init(),
+		m.conversationPane.Init(),
+	}
+	if w := m.client.Watcher(); w != nil {
+		cmds = append(cmds, watchFilesystem(w.Events()))
+	}
+	cmds = append(cmds, connectTaskStream(m.client))
+	return tea.Batch(cmds...)
 }
 
 func (m MainModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
@@ -45,10 +269,32 @@ func (m MainModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	var cmds []tea.Cmd
 
 	switch msg := msg.(type) {
+	case openDialogMsg:
+		return m, m.wm.Push(Window{Model: msg.model, Rect: msg.rect})
+
+	case closeDialogMsg:
+		return m, m.wm.Pop()
+
 	case tea.KeyMsg:
 		if msg.String() == "ctrl+c" {
 			m.quitting = true
-			return m, tea.Quit
+			if m.taskStreamCancel != nil {
+				m.taskStreamCancel()
+			}
+			return m, tea.Batch(m.saveProfileCmd(true), tea.Quit)
+		}
+
+		// While a dialog is open it owns all key input; background panes
+		// keep receiving non-key messages so they stay current underneath.
+		if m.wm.Len() > 0 {
+			return m, m.wm.Update(msg)
+		}
+
+		// Ctrl+k to open the fuzzy-filtered command palette
+		if msg.String() == "ctrl+k" {
+			selected, hasSelected := m.selectedTaskForPalette()
+			palette := NewPalette(m.taskPane.allTasks, m.projectPane.projects, selected, hasSelected, m.taskPane.fetchTasks)
+			return m, OpenDialog(palette, Rect{Width: 60, Height: 18})
 		}
 
 		// Ctrl+p to open project switcher
@@ -56,7 +302,9 @@ func (m MainModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.activeView = "projects"
 			// Trigger fetch
 			return m, func() tea.Msg {
-				projects, err := m.client.ListProjects()
+				ctx, cancel := context.WithTimeout(context.Background(), m.client.Config.ListTimeout)
+				defer cancel()
+				projects, err := m.systems.ListProjects(ctx)
 				if err != nil {
 					return nil // Log?
 				}
@@ -64,8 +312,14 @@ func (m MainModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 		}
 
+		// Ctrl+o to open the saved-conversation browser
+		if msg.String() == "ctrl+o" {
+			m.activeView = "conversations"
+			return m, m.conversationPane.fetchConversations
+		}
+
 		// Tab cycling logic (skip splash and projects)
-		if msg.String() == "tab" && m.activeView != "splash" && m.activeView != "projects" {
+		if msg.String() == "tab" && m.activeView != "splash" && m.activeView != "projects" && m.activeView != "conversations" && m.activeView != "taskform" {
 			switch m.activeView {
 			case "list":
 				m.activeView = "kanban"
@@ -75,9 +329,11 @@ func (m MainModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.activeView = "chat"
 				// Chat doesn't need task sync yet
 			case "chat":
+				m.activeView = "tools"
+			case "tools":
 				m.activeView = "list"
 			}
-			return m, nil
+			return m, m.saveProfileCmd(false)
 		}
 	case tea.WindowSizeMsg:
 		m.width = msg.Width
@@ -93,14 +349,170 @@ func (m MainModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.kanbanPane.Update(msg)
 		m.chatPane.Update(msg)
 		m.projectPane.Update(msg)
+		m.toolPane.Update(msg)
+		m.taskFormPane.Update(msg)
+
+	case OpenTaskFormMsg:
+		if msg.Mode == "edit" {
+			m.taskFormPane.LoadTask(msg.Task)
+		} else {
+			m.taskFormPane.Reset()
+		}
+		m.activeView = "taskform"
+		return m, m.taskFormPane.Init()
+
+	case TaskFormCancelledMsg:
+		if m.wm.Len() > 0 {
+			// Opened as a kanban overlay rather than the full-screen form.
+			return m, m.wm.Pop()
+		}
+		m.activeView = "list"
+		return m, nil
+
+	case TaskFormSubmittedMsg:
+		if m.wm.Len() > 0 {
+			popCmd := m.wm.Pop()
+			m.kanbanPane, cmd = m.kanbanPane.Update(msg)
+			return m, tea.Batch(popCmd, cmd)
+		}
+		m.activeView = "list"
+		m.taskPane, cmd = m.taskPane.Update(msg)
+		return m, cmd
 
 	case ProjectSelectedMsg:
-		// Handle project selection
-		m.client.SetProject(msg.ID, msg.Name)
-		m.activeView = "list" // specific choice: go to list?
+		saveCmd := m.saveProfileCmd(true) // flush the outgoing project's state
+
+		ctx, cancel := context.WithTimeout(context.Background(), m.client.Config.ListTimeout)
+		m.client.SetProject(ctx, msg.ID, msg.Name)
+		cancel()
+
+		m.activeView = "list" // default if this project has no saved profile
+		m.pendingSelectTaskID = ""
+		var conversationID string
+		if saved, ok := m.profiles.Get(msg.ID); ok {
+			m.applyProfile(saved)
+			conversationID = saved.ConversationID
+		}
+		m.lastProfileSave = time.Time{} // next change on this project should save promptly
+
+		cmds = append(cmds, saveCmd, m.taskPane.fetchTasks)
+		// SetProject replaced the watcher; subscribe to the new one (the read
+		// pending on the old, now-closed channel ends with ok=false).
+		if w := m.client.Watcher(); w != nil {
+			cmds = append(cmds, watchFilesystem(w.Events()))
+		}
+		if conversationID != "" {
+			cmds = append(cmds, m.chatPane.LoadConversationByID(conversationID))
+		}
+		return m, tea.Batch(cmds...)
+
+	case ConversationSelectedMsg:
+		m.activeView = "chat"
+		return m, m.chatPane.LoadConversationByID(msg.ConversationID)
+
+	case watchEventMsg:
+		if !msg.ok {
+			return m, nil
+		}
+		m.lastUpdate = msg.event.At
+		var changed tea.Msg
+		switch msg.event.Kind {
+		case client.WatchTasks:
+			changed = TasksChangedMsg{}
+		case client.WatchSessions:
+			changed = SessionsChangedMsg{}
+		case client.WatchFiles:
+			changed = ProjectFilesChangedMsg{}
+		}
+
+		if w := m.client.Watcher(); w != nil {
+			cmds = append(cmds, watchFilesystem(w.Events()))
+		}
+		if changed != nil {
+			updatedModel, cmd := m.Update(changed)
+			m = updatedModel.(MainModel)
+			cmds = append(cmds, cmd)
+		}
+		return m, tea.Batch(cmds...)
 
-		// Trigger refresh of tasks
+	case TasksChangedMsg:
 		return m, m.taskPane.fetchTasks
+
+	case taskStreamUnavailableMsg:
+		// This daemon doesn't expose /tasks/stream; the filesystem watcher
+		// started in Init already covers live updates, so there's nothing
+		// further to do (and nothing to retry).
+		return m, nil
+
+	case taskStreamConnectedMsg:
+		m.taskEvents = msg.events
+		m.taskStreamCancel = msg.cancel
+		return m, readTaskStream(msg.events)
+
+	case taskStreamEventMsg:
+		if !msg.ok {
+			// The connection closed (daemon restart, etc); the filesystem
+			// watcher keeps covering live updates in the meantime.
+			return m, nil
+		}
+		updatedModel, cmd := m.Update(toLiveMsg(msg.event))
+		m = updatedModel.(MainModel)
+		return m, tea.Batch(cmd, readTaskStream(m.taskEvents))
+
+	case []adapter.Task:
+		// Keep both task views current regardless of which is on screen, so
+		// switching views after a background refresh doesn't show stale data.
+		m.taskPane, _ = m.taskPane.Update(msg)
+		m.kanbanPane, _ = m.kanbanPane.Update(msg)
+		if m.pendingSelectTaskID != "" {
+			id := m.pendingSelectTaskID
+			m.pendingSelectTaskID = ""
+			for _, t := range msg {
+				if t.ID == id {
+					updatedModel, cmd := m.Update(JumpToTaskMsg{Task: t})
+					return updatedModel.(MainModel), cmd
+				}
+			}
+		}
+		return m, nil
+
+	case SessionsChangedMsg:
+		// Sessions back the chat pane's parent-session lookup; nothing to
+		// refresh proactively today, but panes can subscribe here later.
+
+	case JumpToTaskMsg:
+		if m.activeView != "kanban" {
+			m.activeView = "list"
+		}
+		m.taskPane, _ = m.taskPane.Update(msg)
+		m.kanbanPane, _ = m.kanbanPane.Update(msg)
+		return m, nil
+
+	case TaskStatusChangeMsg:
+		return m, m.kanbanPane.ApplyStatusChange(msg.Task.ID, msg.Task.SystemID, msg.NewStatus)
+
+	case TaskLiveChangedMsg, TaskLiveDeletedMsg:
+		// Keep both task views current regardless of which is on screen,
+		// same as a []adapter.Task refresh.
+		m.taskPane, _ = m.taskPane.Update(msg)
+		m.kanbanPane, _ = m.kanbanPane.Update(msg)
+		return m, nil
+
+	case ToggleViewMsg:
+		if m.activeView == "kanban" {
+			m.activeView = "list"
+		} else {
+			m.activeView = "kanban"
+			m.kanbanPane.tasks = m.taskPane.tasks
+			m.kanbanPane.distributeTasks()
+		}
+		return m, m.saveProfileCmd(false)
+	}
+
+	// Any message that wasn't already claimed above (e.g. a toast's
+	// auto-close tick) still needs to reach the focused window.
+	if wmCmd := m.wm.Update(msg); wmCmd != nil {
+		cmds = append(cmds, wmCmd)
 	}
 
 	if m.activeView == "splash" {
@@ -124,6 +536,15 @@ func (m MainModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	} else if m.activeView == "projects" {
 		m.projectPane, cmd = m.projectPane.Update(msg)
 		cmds = append(cmds, cmd)
+	} else if m.activeView == "tools" {
+		m.toolPane, cmd = m.toolPane.Update(msg)
+		cmds = append(cmds, cmd)
+	} else if m.activeView == "conversations" {
+		m.conversationPane, cmd = m.conversationPane.Update(msg)
+		cmds = append(cmds, cmd)
+	} else if m.activeView == "taskform" {
+		m.taskFormPane, cmd = m.taskFormPane.Update(msg)
+		cmds = append(cmds, cmd)
 	}
 
 	// Global Quit (allow q in chat if not typing?)
@@ -132,9 +553,12 @@ func (m MainModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	// For now, simpler: ctrl+c is force quit. 'q' only works in list/kanban/splash.
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
-		if m.activeView != "chat" && m.activeView != "projects" && msg.String() == "q" && !m.taskPane.filtering {
+		if m.activeView != "chat" && m.activeView != "projects" && m.activeView != "conversations" && m.activeView != "taskform" && msg.String() == "q" && !m.taskPane.filtering {
 			m.quitting = true
-			return m, tea.Quit
+			if m.taskStreamCancel != nil {
+				m.taskStreamCancel()
+			}
+			return m, tea.Batch(m.saveProfileCmd(true), tea.Quit)
 		}
 	}
 
@@ -153,7 +577,15 @@ func (m MainModel) View() string {
 		return m.projectPane.View()
 	}
 
-	header := SplashTitleStyle.Render(" GOBBY TASKS ") + SubtextStyle.Render(" [Tab] Cycle Views | [Ctrl+p] Projects")
+	if m.activeView == "conversations" {
+		return m.conversationPane.View()
+	}
+
+	if m.activeView == "taskform" {
+		return m.taskFormPane.View()
+	}
+
+	header := SplashTitleStyle.Render(" GOBBY TASKS ") + SubtextStyle.Render(" [Tab] Cycle Views | [Ctrl+k] Palette | [Ctrl+p] Projects | [Ctrl+o] Conversations")
 	if m.client.ProjectID != "" {
 		name := m.client.ProjectName
 		if name == "" {
@@ -163,6 +595,9 @@ func (m MainModel) View() string {
 	} else {
 		header += SubtextStyle.Render(" | Prj: None")
 	}
+	if !m.lastUpdate.IsZero() {
+		header += SubtextStyle.Render(" | Synced: " + m.lastUpdate.Format("15:04:05"))
+	}
 
 	var content string
 	switch m.activeView {
@@ -172,9 +607,15 @@ func (m MainModel) View() string {
 		content = m.kanbanPane.View()
 	case "chat":
 		content = m.chatPane.View()
+	case "tools":
+		content = m.toolPane.View()
 	}
 
-	return BaseStyle.Render(
+	rendered := BaseStyle.Render(
 		"\n" + header + "\n" + content,
 	)
+	if m.wm.Len() > 0 {
+		return m.wm.View(rendered, m.width, m.height)
+	}
+	return rendered
 }
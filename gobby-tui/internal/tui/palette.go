@@ -0,0 +1,137 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	"gobby-tui/internal/adapter"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// JumpToTaskMsg asks whichever pane is on screen (or about to be) to select
+// Task, so choosing a task from the palette jumps straight to it instead of
+// just closing the dialog.
+type JumpToTaskMsg struct {
+	Task adapter.Task
+}
+
+// TaskStatusChangeMsg asks for a quick status transition on Task, the
+// palette's equivalent of KanbanPane's "H"/"L" column moves.
+type TaskStatusChangeMsg struct {
+	Task      adapter.Task
+	NewStatus string
+}
+
+// ToggleViewMsg switches between the list and kanban views; emitted by the
+// palette's "Toggle Kanban/List" action.
+type ToggleViewMsg struct{}
+
+// paletteItem is one entry in the command palette; run is the cmd executed
+// when the user picks it, alongside closing the dialog.
+type paletteItem struct {
+	kind  string // "task", "project", "status", "action" - shown as a hint
+	title string
+	desc  string
+	run   tea.Cmd
+}
+
+func (i paletteItem) Title() string       { return i.title }
+func (i paletteItem) Description() string { return i.kind + " · " + i.desc }
+func (i paletteItem) FilterValue() string { return i.title }
+
+// PaletteModel is the Ctrl+K command palette: a single fuzzy-filtered list
+// (bubbles/list's default filtering is sahilm/fuzzy under the hood)
+// spanning tasks to jump to, projects to switch to, status transitions for
+// the currently selected task, and meta-actions like "New Task".
+type PaletteModel struct {
+	list list.Model
+}
+
+// NewPalette indexes tasks, projects and (if hasSelected) status
+// transitions for selected, plus the fixed meta-actions, into one
+// filterable list. onRefresh is the cmd the "Refresh" action runs, supplied
+// by the caller since the palette has no pane references of its own.
+func NewPalette(tasks []adapter.Task, projects []adapter.Project, selected adapter.Task, hasSelected bool, onRefresh tea.Cmd) PaletteModel {
+	var items []list.Item
+
+	for _, t := range tasks {
+		t := t
+		items = append(items, paletteItem{
+			kind:  "task",
+			title: t.Title,
+			desc:  fmt.Sprintf("#%d · %s", t.SeqNum, t.Status),
+			run:   func() tea.Msg { return JumpToTaskMsg{Task: t} },
+		})
+	}
+
+	for _, p := range projects {
+		p := p
+		items = append(items, paletteItem{
+			kind:  "project",
+			title: p.Name,
+			desc:  "switch project",
+			run:   func() tea.Msg { return ProjectSelectedMsg{ID: p.ID, Name: p.Name} },
+		})
+	}
+
+	if hasSelected {
+		for _, status := range taskStatuses {
+			if status == selected.Status {
+				continue
+			}
+			status := status
+			items = append(items, paletteItem{
+				kind:  "status",
+				title: "Move to " + status,
+				desc:  selected.Title,
+				run:   func() tea.Msg { return TaskStatusChangeMsg{Task: selected, NewStatus: status} },
+			})
+		}
+	}
+
+	items = append(items,
+		paletteItem{kind: "action", title: "New Task", desc: "open the task form",
+			run: func() tea.Msg { return OpenTaskFormMsg{Mode: "create"} }},
+		paletteItem{kind: "action", title: "Refresh", desc: "reload tasks", run: onRefresh},
+		paletteItem{kind: "action", title: "Toggle Kanban/List", desc: "switch board view",
+			run: func() tea.Msg { return ToggleViewMsg{} }},
+	)
+
+	l := list.New(items, list.NewDefaultDelegate(), 50, 16)
+	l.Title = "Command Palette"
+	l.SetShowStatusBar(false)
+	l.SetFilteringEnabled(true)
+	l.Styles.Title = SplashTitleStyle
+
+	return PaletteModel{list: l}
+}
+
+func (m PaletteModel) Init() tea.Cmd { return nil }
+
+func (m PaletteModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if key, ok := msg.(tea.KeyMsg); ok {
+		switch key.String() {
+		case "esc":
+			if m.list.FilterState() == list.Filtering {
+				break // let the list clear its own filter first
+			}
+			return m, CloseDialog()
+		case "enter":
+			item, ok := m.list.SelectedItem().(paletteItem)
+			if ok && item.run != nil {
+				return m, tea.Batch(CloseDialog(), item.run)
+			}
+			return m, CloseDialog()
+		}
+	}
+
+	var cmd tea.Cmd
+	m.list, cmd = m.list.Update(msg)
+	return m, cmd
+}
+
+func (m PaletteModel) View() string {
+	return strings.TrimRight(m.list.View(), "\n")
+}
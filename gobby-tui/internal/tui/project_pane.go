@@ -1,11 +1,14 @@
 package tui
 
 import (
+	"context"
 	"fmt"
-	"gobby-tui/internal/client"
 	"io"
 	"strings"
 
+	"gobby-tui/internal/adapter"
+	"gobby-tui/internal/client"
+
 	"github.com/charmbracelet/bubbles/list"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
@@ -46,12 +49,14 @@ var (
 )
 
 type ProjectPane struct {
-	client *client.GobbyClient
-	list   list.Model
-	err    error
+	client   *client.GobbyClient
+	systems  *adapter.Aggregator
+	list     list.Model
+	projects []adapter.Project
+	err      error
 }
 
-func NewProjectPane(c *client.GobbyClient) ProjectPane {
+func NewProjectPane(c *client.GobbyClient, systems *adapter.Aggregator) ProjectPane {
 	items := []list.Item{}
 
 	// Create list with default delegate
@@ -63,8 +68,9 @@ func NewProjectPane(c *client.GobbyClient) ProjectPane {
 	l.Styles.Title = SplashTitleStyle
 
 	return ProjectPane{
-		client: c,
-		list:   l,
+		client:  c,
+		systems: systems,
+		list:    l,
 	}
 }
 
@@ -79,7 +85,8 @@ func (m ProjectPane) Update(msg tea.Msg) (ProjectPane, tea.Cmd) {
 		m.list.SetWidth(msg.Width)
 		m.list.SetHeight(msg.Height - 4) // Leave room?
 
-	case []client.Project:
+	case []adapter.Project:
+		m.projects = msg
 		// Convert to list items
 		items := make([]list.Item, len(msg))
 		for i, p := range msg {
@@ -114,8 +121,13 @@ type ProjectSelectedMsg struct {
 	Name string
 }
 
+// FetchProjects aggregates projects across every registered TaskSystem, not
+// just the Gobby daemon, so the switcher lists every backend's projects
+// together.
 func (m *ProjectPane) FetchProjects() tea.Msg {
-	projects, err := m.client.ListProjects()
+	ctx, cancel := context.WithTimeout(context.Background(), m.client.Config.ListTimeout)
+	defer cancel()
+	projects, err := m.systems.ListProjects(ctx)
 	if err != nil {
 		return err // Handle error better?
 	}
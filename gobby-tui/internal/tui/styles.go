@@ -9,6 +9,7 @@ var (
 	ColorDark      = lipgloss.Color("#1e1e2e")
 	ColorText      = lipgloss.Color("#cdd6f4")
 	ColorSubtext   = lipgloss.Color("#a6adc8")
+	ColorWarning   = lipgloss.Color("#f38ba8")
 
 	// Base Styles
 	BaseStyle = lipgloss.NewStyle().
@@ -22,4 +23,19 @@ var (
 
 	SubtextStyle = lipgloss.NewStyle().
 			Foreground(ColorSubtext)
+
+	// Overlay window styles
+	DialogStyle = lipgloss.NewStyle().
+			Foreground(ColorText).
+			Background(ColorDark).
+			Border(lipgloss.RoundedBorder()).
+			BorderForeground(ColorPrimary).
+			Padding(1, 2)
+
+	ToastStyle = lipgloss.NewStyle().
+			Foreground(ColorText).
+			Background(ColorDark).
+			Border(lipgloss.RoundedBorder()).
+			BorderForeground(ColorSecondary).
+			Padding(0, 1)
 )
@@ -0,0 +1,78 @@
+package tui
+
+import (
+	"context"
+	"time"
+
+	"gobby-tui/internal/adapter"
+	"gobby-tui/internal/client"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// TaskUpdatedMsg reports the outcome of a status-change mutation (e.g. a
+// kanban column move); panes that applied the change optimistically revert
+// it when Err is set.
+type TaskUpdatedMsg struct {
+	ID     string
+	Status string
+	Err    error
+}
+
+// TaskDeletedMsg reports the outcome of a delete mutation.
+type TaskDeletedMsg struct {
+	ID  string
+	Err error
+}
+
+// updateTaskStatusCmd routes the mutation to systemID through the
+// aggregator and reports the result as a TaskUpdatedMsg. KanbanPane's
+// "H"/"L" column moves and its ApplyStatusChange (palette status changes)
+// both use it, so they share the same optimistic-update/rollback pipeline.
+func updateTaskStatusCmd(systems *adapter.Aggregator, timeout time.Duration, systemID, id, status string) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+		err := systems.UpdateTask(ctx, systemID, id, status)
+		return TaskUpdatedMsg{ID: id, Status: status, Err: err}
+	}
+}
+
+// deleteTaskCmd routes the deletion to systemID through the aggregator and
+// reports the result as a TaskDeletedMsg.
+func deleteTaskCmd(systems *adapter.Aggregator, timeout time.Duration, systemID, id string) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+		err := systems.DeleteTask(ctx, systemID, id)
+		return TaskDeletedMsg{ID: id, Err: err}
+	}
+}
+
+// taskMutatedMsg reports the outcome of a CreateTaskFull/UpdateTaskFull
+// round-trip; tempID/original carry whatever's needed to roll back the
+// optimistic update the caller already made.
+type taskMutatedMsg struct {
+	mode     string // "create" or "edit"
+	tempID   string
+	original adapter.Task
+	err      error
+}
+
+// submitTaskFormCmd round-trips msg to the daemon through c and reports the
+// outcome as a taskMutatedMsg, shared by TaskPane and KanbanPane so both
+// form-submission paths go through the same optimistic-create/rollback
+// pipeline.
+func submitTaskFormCmd(c *client.GobbyClient, msg TaskFormSubmittedMsg, tempID string, original adapter.Task) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), c.Config.ListTimeout)
+		defer cancel()
+		var err error
+		if msg.Mode == "create" {
+			err = c.CreateTaskFull(ctx, msg.Data)
+		} else {
+			err = c.UpdateTaskFull(ctx, msg.TaskID, msg.Data)
+		}
+		return taskMutatedMsg{mode: msg.Mode, tempID: tempID, original: original, err: err}
+	}
+}
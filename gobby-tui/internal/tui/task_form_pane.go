@@ -0,0 +1,302 @@
+package tui
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"gobby-tui/internal/adapter"
+	"gobby-tui/internal/client"
+
+	"github.com/charmbracelet/bubbles/textarea"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// taskFormField identifies which field currently has focus in TaskFormPane.
+type taskFormField int
+
+const (
+	fieldTitle taskFormField = iota
+	fieldDescription
+	fieldPriority
+	fieldStatus
+	fieldParentID
+	taskFormFieldCount
+)
+
+var taskStatuses = []string{"todo", "in_progress", "blocked", "done"}
+
+// TaskFormPane is a modal form for creating or editing a task, collecting
+// the fields TaskPane's table can't edit inline: description, priority,
+// status, and parent task ID.
+type TaskFormPane struct {
+	client *client.GobbyClient
+
+	mode   string // "create" or "edit"
+	taskID string
+
+	title       textinput.Model
+	description textarea.Model
+	priority    textinput.Model
+	parentID    textinput.Model
+	statusIdx   int
+	focus       taskFormField
+
+	err    error
+	width  int
+	height int
+}
+
+// OpenTaskFormMsg asks MainModel to switch to the task form, either empty
+// (create, Task is the zero value) or pre-filled from an existing task
+// (edit).
+type OpenTaskFormMsg struct {
+	Mode string
+	Task adapter.Task
+}
+
+// TaskFormSubmittedMsg is emitted when the user confirms the form; TaskPane
+// applies it optimistically and kicks off the daemon round-trip.
+type TaskFormSubmittedMsg struct {
+	Mode   string // "create" or "edit"
+	TaskID string
+	Data   client.TaskFormData
+}
+
+// TaskFormCancelledMsg is emitted on Esc.
+type TaskFormCancelledMsg struct{}
+
+func NewTaskFormPane(c *client.GobbyClient) TaskFormPane {
+	title := textinput.New()
+	title.Placeholder = "Title"
+	title.CharLimit = 120
+
+	desc := textarea.New()
+	desc.Placeholder = "Description..."
+	desc.ShowLineNumbers = false
+	desc.SetHeight(4)
+
+	priority := textinput.New()
+	priority.Placeholder = "1-5"
+	priority.CharLimit = 1
+
+	parentID := textinput.New()
+	parentID.Placeholder = "Parent task ID (optional)"
+
+	return TaskFormPane{
+		client:      c,
+		title:       title,
+		description: desc,
+		priority:    priority,
+		parentID:    parentID,
+		mode:        "create",
+	}
+}
+
+// Reset prepares the form for creating a brand new task.
+func (m *TaskFormPane) Reset() {
+	m.mode = "create"
+	m.taskID = ""
+	m.title.SetValue("")
+	m.description.SetValue("")
+	m.priority.SetValue("3")
+	m.parentID.SetValue("")
+	m.statusIdx = 0
+	m.err = nil
+	m.focus = fieldTitle
+	m.focusCurrent()
+}
+
+// LoadTask prepares the form to edit an existing task.
+func (m *TaskFormPane) LoadTask(t adapter.Task) {
+	m.mode = "edit"
+	m.taskID = t.ID
+	m.title.SetValue(t.Title)
+	m.description.SetValue(t.Description)
+	m.priority.SetValue(fmt.Sprintf("%d", t.Priority))
+	m.parentID.SetValue(t.ParentID)
+	m.statusIdx = 0
+	for i, s := range taskStatuses {
+		if s == t.Status {
+			m.statusIdx = i
+			break
+		}
+	}
+	m.err = nil
+	m.focus = fieldTitle
+	m.focusCurrent()
+}
+
+func (m *TaskFormPane) focusCurrent() {
+	m.title.Blur()
+	m.description.Blur()
+	m.priority.Blur()
+	m.parentID.Blur()
+	switch m.focus {
+	case fieldTitle:
+		m.title.Focus()
+	case fieldDescription:
+		m.description.Focus()
+	case fieldPriority:
+		m.priority.Focus()
+	case fieldParentID:
+		m.parentID.Focus()
+	}
+}
+
+func (m TaskFormPane) Init() tea.Cmd {
+	return textinput.Blink
+}
+
+func (m TaskFormPane) Update(msg tea.Msg) (TaskFormPane, tea.Cmd) {
+	var cmd tea.Cmd
+	var cmds []tea.Cmd
+
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+		m.title.Width = m.width - 4
+		m.description.SetWidth(m.width - 4)
+		m.parentID.Width = m.width - 4
+
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "esc":
+			return m, func() tea.Msg { return TaskFormCancelledMsg{} }
+		case "tab":
+			m.focus = (m.focus + 1) % taskFormFieldCount
+			m.focusCurrent()
+			return m, textinput.Blink
+		case "shift+tab":
+			m.focus = (m.focus - 1 + taskFormFieldCount) % taskFormFieldCount
+			m.focusCurrent()
+			return m, textinput.Blink
+		case "left":
+			if m.focus == fieldStatus {
+				m.statusIdx = (m.statusIdx - 1 + len(taskStatuses)) % len(taskStatuses)
+				return m, nil
+			}
+		case "right":
+			if m.focus == fieldStatus {
+				m.statusIdx = (m.statusIdx + 1) % len(taskStatuses)
+				return m, nil
+			}
+		case "ctrl+s":
+			return m, m.submit()
+		case "enter":
+			if m.focus == fieldDescription {
+				break // allow newlines in the description textarea
+			}
+			return m, m.submit()
+		}
+	}
+
+	switch m.focus {
+	case fieldTitle:
+		m.title, cmd = m.title.Update(msg)
+		cmds = append(cmds, cmd)
+	case fieldDescription:
+		m.description, cmd = m.description.Update(msg)
+		cmds = append(cmds, cmd)
+	case fieldPriority:
+		m.priority, cmd = m.priority.Update(msg)
+		cmds = append(cmds, cmd)
+	case fieldParentID:
+		m.parentID, cmd = m.parentID.Update(msg)
+		cmds = append(cmds, cmd)
+	}
+
+	return m, tea.Batch(cmds...)
+}
+
+// submit validates the form and, if valid, returns a cmd emitting
+// TaskFormSubmittedMsg. On a validation error it sets m.err instead so the
+// caller's View renders it.
+func (m *TaskFormPane) submit() tea.Cmd {
+	data, err := m.validate()
+	if err != nil {
+		m.err = err
+		return nil
+	}
+	m.err = nil
+	mode, taskID := m.mode, m.taskID
+	return func() tea.Msg {
+		return TaskFormSubmittedMsg{Mode: mode, TaskID: taskID, Data: data}
+	}
+}
+
+// validate checks the form's required fields and returns the data to submit.
+func (m TaskFormPane) validate() (client.TaskFormData, error) {
+	title := strings.TrimSpace(m.title.Value())
+	if title == "" {
+		return client.TaskFormData{}, fmt.Errorf("title is required")
+	}
+	priority, err := strconv.Atoi(strings.TrimSpace(m.priority.Value()))
+	if err != nil || priority < 1 || priority > 5 {
+		return client.TaskFormData{}, fmt.Errorf("priority must be a number between 1 and 5")
+	}
+	return client.TaskFormData{
+		Title:       title,
+		Description: m.description.Value(),
+		Priority:    priority,
+		Status:      taskStatuses[m.statusIdx],
+		ParentID:    strings.TrimSpace(m.parentID.Value()),
+	}, nil
+}
+
+// taskFormWindow adapts TaskFormPane to tea.Model so it can be pushed onto
+// the WindowManager stack as a kanban overlay; TaskFormPane.Update returns
+// its own concrete type rather than tea.Model, since it's also driven
+// directly as MainModel's full-screen "taskform" view.
+type taskFormWindow struct {
+	form TaskFormPane
+}
+
+func (w taskFormWindow) Init() tea.Cmd { return w.form.Init() }
+
+func (w taskFormWindow) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	form, cmd := w.form.Update(msg)
+	w.form = form
+	return w, cmd
+}
+
+func (w taskFormWindow) View() string { return w.form.View() }
+
+func (m TaskFormPane) View() string {
+	label := func(f taskFormField, text string) string {
+		if m.focus == f {
+			return selectedItemStyle.Render("> " + text)
+		}
+		return itemStyle.Render(text)
+	}
+
+	heading := "New Task"
+	if m.mode == "edit" {
+		heading = "Edit Task"
+	}
+
+	statusOptions := make([]string, len(taskStatuses))
+	for i, s := range taskStatuses {
+		if i == m.statusIdx {
+			statusOptions[i] = selectedItemStyle.Render("[" + s + "]")
+		} else {
+			statusOptions[i] = itemStyle.Render(s)
+		}
+	}
+
+	b := SplashTitleStyle.Render(" "+heading+" ") + "\n\n"
+	b += label(fieldTitle, "Title") + "\n" + m.title.View() + "\n\n"
+	b += label(fieldDescription, "Description") + "\n" + m.description.View() + "\n\n"
+	b += label(fieldPriority, "Priority") + "\n" + m.priority.View() + "\n\n"
+	b += label(fieldStatus, "Status") + "\n  " + strings.Join(statusOptions, "  ") + "\n\n"
+	b += label(fieldParentID, "Parent task ID") + "\n" + m.parentID.View() + "\n\n"
+
+	if m.err != nil {
+		b += SubtextStyle.Render(fmt.Sprintf("Error: %v", m.err)) + "\n\n"
+	}
+	b += SubtextStyle.Render("[Tab] next field  [←/→] change status  [Ctrl+s] save  [Esc] cancel")
+
+	return BaseStyle.Render(b)
+}
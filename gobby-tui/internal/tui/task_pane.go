@@ -1,9 +1,13 @@
 package tui
 
 import (
+	"context"
 	"fmt"
-	"gobby-tui/internal/client"
 	"strings"
+	"time"
+
+	"gobby-tui/internal/adapter"
+	"gobby-tui/internal/client"
 
 	"github.com/charmbracelet/bubbles/table"
 	"github.com/charmbracelet/bubbles/textinput"
@@ -12,16 +16,22 @@ import (
 )
 
 type TaskPane struct {
-	client    *client.GobbyClient
+	client  *client.GobbyClient
+	systems *adapter.Aggregator
+
 	table     table.Model
 	input     textinput.Model
-	allTasks  []client.Task
-	tasks     []client.Task
+	allTasks  []adapter.Task
+	tasks     []adapter.Task
 	filtering bool
-	err       error
+
+	confirmingDelete bool
+	pendingDelete    adapter.Task
+
+	err error
 }
 
-func NewTaskPane(c *client.GobbyClient) TaskPane {
+func NewTaskPane(c *client.GobbyClient, systems *adapter.Aggregator) TaskPane {
 	columns := []table.Column{
 		{Title: "ID", Width: 10},
 		{Title: "Title", Width: 40},
@@ -52,9 +62,10 @@ func NewTaskPane(c *client.GobbyClient) TaskPane {
 	ti.CharLimit = 20
 
 	return TaskPane{
-		client: c,
-		table:  t,
-		input:  ti,
+		client:  c,
+		systems: systems,
+		table:   t,
+		input:   ti,
 	}
 }
 
@@ -65,11 +76,58 @@ func (m TaskPane) Init() tea.Cmd {
 func (m TaskPane) Update(msg tea.Msg) (TaskPane, tea.Cmd) {
 	var cmd tea.Cmd
 	switch msg := msg.(type) {
-	case []client.Task:
+	case []adapter.Task:
 		m.allTasks = msg
 		m.filterTasks()
 
+	case TaskFormSubmittedMsg:
+		return m.applyFormSubmission(msg)
+
+	case taskMutatedMsg:
+		return m.applyMutationResult(msg)
+
+	case TaskDeletedMsg:
+		if msg.Err != nil {
+			m.err = msg.Err
+			m.allTasks = append(m.allTasks, m.pendingDelete)
+			m.filterTasks()
+		}
+		m.pendingDelete = adapter.Task{}
+		return m, nil
+
+	case JumpToTaskMsg:
+		for i, t := range m.tasks {
+			if t.ID == msg.Task.ID {
+				m.table.SetCursor(i)
+				break
+			}
+		}
+		return m, nil
+
+	case TaskLiveChangedMsg:
+		m.applyLiveChange(msg.Task)
+		return m, nil
+
+	case TaskLiveDeletedMsg:
+		m.applyLiveDelete(msg.ID)
+		return m, nil
+
 	case tea.KeyMsg:
+		if m.confirmingDelete {
+			switch msg.String() {
+			case "y", "enter":
+				m.confirmingDelete = false
+				id, systemID := m.pendingDelete.ID, m.pendingDelete.SystemID
+				m.allTasks = removeTask(m.allTasks, id)
+				m.filterTasks()
+				return m, deleteTaskCmd(m.systems, m.client.Config.ListTimeout, systemID, id)
+			case "n", "esc":
+				m.confirmingDelete = false
+				m.pendingDelete = adapter.Task{}
+			}
+			return m, nil
+		}
+
 		if m.filtering {
 			switch msg.String() {
 			case "enter", "esc":
@@ -89,6 +147,18 @@ func (m TaskPane) Update(msg tea.Msg) (TaskPane, tea.Cmd) {
 			return m, textinput.Blink
 		case "r":
 			return m, m.fetchTasks
+		case "n":
+			return m, func() tea.Msg { return OpenTaskFormMsg{Mode: "create"} }
+		case "e":
+			if t, ok := m.selectedTask(); ok {
+				return m, func() tea.Msg { return OpenTaskFormMsg{Mode: "edit", Task: t} }
+			}
+		case "d":
+			if t, ok := m.selectedTask(); ok {
+				m.confirmingDelete = true
+				m.pendingDelete = t
+			}
+			return m, nil
 		}
 	}
 
@@ -96,17 +166,157 @@ func (m TaskPane) Update(msg tea.Msg) (TaskPane, tea.Cmd) {
 	return m, cmd
 }
 
-func (m TaskPane) View() string {
-	if m.err != nil {
-		return fmt.Sprintf("Error fetching tasks: %v", m.err)
+// applyLiveChange upserts a single task pushed by the daemon's SSE live
+// update stream, preserving whichever task is currently selected instead of
+// the wholesale reset a manual or filesystem-triggered refresh does.
+func (m *TaskPane) applyLiveChange(t adapter.Task) {
+	selectedID, hadSelection := m.selectedTaskID()
+
+	found := false
+	for i, existing := range m.allTasks {
+		if existing.ID == t.ID {
+			m.allTasks[i] = t
+			found = true
+			break
+		}
+	}
+	if !found {
+		m.allTasks = append(m.allTasks, t)
+	}
+	m.filterTasks()
+
+	if hadSelection {
+		m.restoreSelection(selectedID)
+	}
+}
+
+// applyLiveDelete removes a task reported deleted by the live update
+// stream, likewise preserving selection if something else is selected.
+func (m *TaskPane) applyLiveDelete(id string) {
+	selectedID, hadSelection := m.selectedTaskID()
+
+	m.allTasks = removeTask(m.allTasks, id)
+	m.filterTasks()
+
+	if hadSelection && selectedID != id {
+		m.restoreSelection(selectedID)
+	}
+}
+
+func (m TaskPane) selectedTaskID() (string, bool) {
+	if t, ok := m.selectedTask(); ok {
+		return t.ID, true
+	}
+	return "", false
+}
+
+func (m *TaskPane) restoreSelection(id string) {
+	for i, t := range m.tasks {
+		if t.ID == id {
+			m.table.SetCursor(i)
+			return
+		}
+	}
+}
+
+// SetFilter applies a previously-saved filter string (e.g. from a loaded
+// profile) and re-filters the table against it.
+func (m *TaskPane) SetFilter(s string) {
+	m.input.SetValue(s)
+	m.filterTasks()
+}
+
+// selectedTask returns the task backing the table's currently highlighted
+// row, in the same filtered order filterTasks used to build the rows.
+func (m TaskPane) selectedTask() (adapter.Task, bool) {
+	idx := m.table.Cursor()
+	if idx < 0 || idx >= len(m.tasks) {
+		return adapter.Task{}, false
+	}
+	return m.tasks[idx], true
+}
+
+func removeTask(tasks []adapter.Task, id string) []adapter.Task {
+	var kept []adapter.Task
+	for _, t := range tasks {
+		if t.ID != id {
+			kept = append(kept, t)
+		}
+	}
+	return kept
+}
+
+// applyFormSubmission optimistically applies a create/edit before the
+// daemon round-trip completes, so the table reflects the change instantly;
+// applyMutationResult rolls it back if the call fails.
+func (m TaskPane) applyFormSubmission(msg TaskFormSubmittedMsg) (TaskPane, tea.Cmd) {
+	if msg.Mode == "create" {
+		tempID := fmt.Sprintf("pending-%d", time.Now().UnixNano())
+		m.allTasks = append(m.allTasks, adapter.Task{
+			ID:       tempID,
+			Title:    msg.Data.Title,
+			Status:   msg.Data.Status,
+			Priority: msg.Data.Priority,
+			SystemID: adapter.GobbySystemID,
+		})
+		m.filterTasks()
+		return m, submitTaskFormCmd(m.client, msg, tempID, adapter.Task{})
+	}
+
+	var original adapter.Task
+	for i, t := range m.allTasks {
+		if t.ID == msg.TaskID {
+			original = t
+			m.allTasks[i].Title = msg.Data.Title
+			m.allTasks[i].Status = msg.Data.Status
+			m.allTasks[i].Priority = msg.Data.Priority
+			break
+		}
+	}
+	m.filterTasks()
+	return m, submitTaskFormCmd(m.client, msg, "", original)
+}
+
+func (m TaskPane) applyMutationResult(msg taskMutatedMsg) (TaskPane, tea.Cmd) {
+	if msg.err == nil {
+		if msg.mode == "create" {
+			// The daemon assigns the real ID/seq_num; refetch to replace
+			// the optimistic placeholder with the authoritative row.
+			return m, m.fetchTasks
+		}
+		return m, nil
 	}
 
+	m.err = msg.err
+	if msg.mode == "create" {
+		m.allTasks = removeTask(m.allTasks, msg.tempID)
+	} else {
+		for i, t := range m.allTasks {
+			if t.ID == msg.original.ID {
+				m.allTasks[i] = msg.original
+				break
+			}
+		}
+	}
+	m.filterTasks()
+	return m, nil
+}
+
+func (m TaskPane) View() string {
 	view := BaseStyle.Render(m.table.View())
 
-	if m.filtering {
+	if m.confirmingDelete {
+		view += "\n" + SubtextStyle.Render(fmt.Sprintf("Delete %q? [y/n]", m.pendingDelete.Title))
+	} else if m.filtering {
 		view += "\n" + m.input.View()
 	} else if m.input.Value() != "" {
 		view += "\n" + SubtextStyle.Render("Filter: "+m.input.Value())
+	} else {
+		view += "\n" + SubtextStyle.Render("[n] new  [e] edit  [d] delete  [/] filter  [r] refresh")
+	}
+
+	if m.err != nil {
+		view += "\n" + fmt.Sprintf("Error: %v", m.err)
 	}
 
 	return view
@@ -114,7 +324,7 @@ func (m TaskPane) View() string {
 
 func (m *TaskPane) filterTasks() {
 	term := strings.ToLower(m.input.Value())
-	var filtered []client.Task
+	var filtered []adapter.Task
 	for _, t := range m.allTasks {
 		if strings.Contains(strings.ToLower(t.Title), term) ||
 			strings.Contains(strings.ToLower(t.ID), term) {
@@ -141,7 +351,9 @@ func (m *TaskPane) filterTasks() {
 }
 
 func (m TaskPane) fetchTasks() tea.Msg {
-	tasks, err := m.client.ListReadyTasks()
+	ctx, cancel := context.WithTimeout(context.Background(), m.client.Config.ListTimeout)
+	defer cancel()
+	tasks, err := m.systems.ListTasks(ctx, "")
 	if err != nil {
 		return err
 	}
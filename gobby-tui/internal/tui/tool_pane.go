@@ -0,0 +1,363 @@
+package tui
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gobby-tui/internal/client"
+	"gobby-tui/pkg/agents"
+
+	"github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/bubbles/textarea"
+	"github.com/charmbracelet/bubbles/textinput"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// ToolPane lets the user browse tools discovered from the daemon, inspect
+// their JSON schemas, and invoke them interactively. When a tool's schema
+// declares object properties, the form is one text field per property
+// (formMode); otherwise it falls back to a raw JSON arguments textarea.
+type ToolPane struct {
+	client  *client.GobbyClient
+	toolbox *agents.Toolbox
+	agent   agents.Agent
+	list    list.Model
+	schema  viewport.Model
+	args    textarea.Model
+
+	formMode bool
+	fields   []string
+	inputs   map[string]*textinput.Model
+	propType map[string]string
+	required map[string]bool
+	fieldIdx int
+
+	result   string
+	invoking bool
+	err      error
+	width    int
+	height   int
+}
+
+type toolItem struct {
+	tool client.ToolInfo
+}
+
+func (i toolItem) Title() string       { return i.tool.Name }
+func (i toolItem) Description() string { return i.tool.Description }
+func (i toolItem) FilterValue() string { return i.tool.Name }
+
+type toolsDiscoveredMsg []client.ToolInfo
+type toolsErrorMsg error
+type toolInvokedMsg struct {
+	result map[string]interface{}
+	err    error
+}
+
+// toolSchema is the subset of a JSON Schema object ToolPane needs to turn a
+// tool's arguments into a form: each property's declared type (used to
+// coerce the field's text back into JSON) and which ones are required.
+type toolSchema struct {
+	Properties map[string]struct {
+		Type        string `json:"type"`
+		Description string `json:"description"`
+	} `json:"properties"`
+	Required []string `json:"required"`
+}
+
+// buildSchemaForm parses a tool's JSON schema into one textinput per
+// property, sorted by name for a stable layout. It returns ok=false (and no
+// fields) for schemas with no declared properties, so the caller can fall
+// back to the raw JSON textarea.
+func buildSchemaForm(raw json.RawMessage) (fields []string, inputs map[string]*textinput.Model, types map[string]string, required map[string]bool, ok bool) {
+	var s toolSchema
+	if err := json.Unmarshal(raw, &s); err != nil || len(s.Properties) == 0 {
+		return nil, nil, nil, nil, false
+	}
+
+	required = make(map[string]bool, len(s.Required))
+	for _, r := range s.Required {
+		required[r] = true
+	}
+
+	fields = make([]string, 0, len(s.Properties))
+	for name := range s.Properties {
+		fields = append(fields, name)
+	}
+	sort.Strings(fields)
+
+	types = make(map[string]string, len(fields))
+	inputs = make(map[string]*textinput.Model, len(fields))
+	for _, name := range fields {
+		p := s.Properties[name]
+		types[name] = p.Type
+
+		placeholder := p.Type
+		if placeholder == "" {
+			placeholder = "string"
+		}
+		if required[name] {
+			placeholder += ", required"
+		}
+		if p.Description != "" {
+			placeholder += ": " + p.Description
+		}
+
+		ti := textinput.New()
+		ti.Placeholder = placeholder
+		inputs[name] = &ti
+	}
+
+	return fields, inputs, types, required, true
+}
+
+func NewToolPane(c *client.GobbyClient) ToolPane {
+	l := list.New([]list.Item{}, list.NewDefaultDelegate(), 40, 20)
+	l.Title = "Tools"
+	l.SetShowHelp(false)
+
+	vp := viewport.New(40, 10)
+	vp.SetContent("Select a tool to view its schema.")
+
+	ta := textarea.New()
+	ta.Placeholder = `{"key": "value"}`
+	ta.ShowLineNumbers = false
+
+	return ToolPane{
+		client:  c,
+		toolbox: agents.NewToolbox(c),
+		agent:   agents.NewAgent("default", ""),
+		list:    l,
+		schema:  vp,
+		args:    ta,
+	}
+}
+
+// SetAgent restricts the tools advertised in this pane to those the given
+// agent is allowed to use.
+func (m *ToolPane) SetAgent(a agents.Agent) {
+	m.agent = a
+	m.applyFilter()
+}
+
+func (m ToolPane) Init() tea.Cmd {
+	return m.discoverTools
+}
+
+func (m ToolPane) discoverTools() tea.Msg {
+	ctx, cancel := context.WithTimeout(context.Background(), m.client.Config.ListTimeout)
+	defer cancel()
+	if err := m.toolbox.Discover(ctx); err != nil {
+		return toolsErrorMsg(err)
+	}
+	return toolsDiscoveredMsg(m.toolbox.Tools())
+}
+
+func (m *ToolPane) applyFilter() {
+	tools := m.toolbox.ToolsFor(m.agent)
+	items := make([]list.Item, len(tools))
+	for i, t := range tools {
+		items[i] = toolItem{tool: t}
+	}
+	m.list.SetItems(items)
+}
+
+func (m ToolPane) Update(msg tea.Msg) (ToolPane, tea.Cmd) {
+	var cmd tea.Cmd
+	var cmds []tea.Cmd
+
+	switch msg := msg.(type) {
+	case toolsDiscoveredMsg:
+		m.applyFilter()
+
+	case toolsErrorMsg:
+		m.err = msg
+
+	case toolInvokedMsg:
+		m.invoking = false
+		if msg.err != nil {
+			m.result = fmt.Sprintf("error: %v", msg.err)
+		} else {
+			pretty, _ := json.MarshalIndent(msg.result, "", "  ")
+			m.result = string(pretty)
+		}
+
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+		m.list.SetSize(m.width/2, m.height-4)
+		m.schema.Width = m.width / 2
+		m.schema.Height = m.height - 4 - m.args.Height()
+		m.args.SetWidth(m.width / 2)
+		for _, ti := range m.inputs {
+			ti.Width = m.width/2 - 4
+		}
+
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "enter":
+			if item, ok := m.list.SelectedItem().(toolItem); ok {
+				m.schema.SetContent(item.tool.Description + "\n\n" + string(item.tool.Schema))
+				m.result = ""
+				if fields, inputs, types, required, ok := buildSchemaForm(item.tool.Schema); ok {
+					m.formMode = true
+					m.fields = fields
+					m.inputs = inputs
+					m.propType = types
+					m.required = required
+					m.fieldIdx = 0
+					m.focusField()
+					return m, textinput.Blink
+				}
+				m.formMode = false
+				m.args.Focus()
+				return m, textarea.Blink
+			}
+		case "tab":
+			if m.formMode && len(m.fields) > 0 {
+				m.fieldIdx = (m.fieldIdx + 1) % len(m.fields)
+				m.focusField()
+				return m, textinput.Blink
+			}
+		case "shift+tab":
+			if m.formMode && len(m.fields) > 0 {
+				m.fieldIdx = (m.fieldIdx - 1 + len(m.fields)) % len(m.fields)
+				m.focusField()
+				return m, textinput.Blink
+			}
+		case "ctrl+s":
+			item, ok := m.list.SelectedItem().(toolItem)
+			if !ok || m.invoking {
+				return m, nil
+			}
+			var parsed map[string]interface{}
+			if m.formMode {
+				args, err := m.formArgs()
+				if err != nil {
+					m.result = fmt.Sprintf("invalid arguments: %v", err)
+					return m, nil
+				}
+				parsed = args
+			} else {
+				raw := strings.TrimSpace(m.args.Value())
+				if raw != "" {
+					if err := json.Unmarshal([]byte(raw), &parsed); err != nil {
+						m.result = fmt.Sprintf("invalid JSON arguments: %v", err)
+						return m, nil
+					}
+				}
+			}
+			m.invoking = true
+			toolName := item.tool.Name
+			return m, func() tea.Msg {
+				ctx, cancel := context.WithTimeout(context.Background(), m.client.Config.ChatTimeout)
+				defer cancel()
+				res, err := m.toolbox.Invoke(ctx, toolName, parsed)
+				return toolInvokedMsg{result: res, err: err}
+			}
+		}
+	}
+
+	m.list, cmd = m.list.Update(msg)
+	cmds = append(cmds, cmd)
+	if m.formMode && len(m.fields) > 0 {
+		name := m.fields[m.fieldIdx]
+		updated, c := m.inputs[name].Update(msg)
+		m.inputs[name] = &updated
+		cmds = append(cmds, c)
+	} else {
+		m.args, cmd = m.args.Update(msg)
+		cmds = append(cmds, cmd)
+	}
+
+	return m, tea.Batch(cmds...)
+}
+
+// focusField focuses the input at m.fieldIdx and blurs every other one.
+func (m *ToolPane) focusField() {
+	for i, name := range m.fields {
+		if i == m.fieldIdx {
+			m.inputs[name].Focus()
+		} else {
+			m.inputs[name].Blur()
+		}
+	}
+}
+
+// formArgs converts the schema-derived form's field values into the
+// argument map Invoke expects, coercing each value to the type its schema
+// property declared. Empty, non-required fields are omitted.
+func (m ToolPane) formArgs() (map[string]interface{}, error) {
+	args := make(map[string]interface{}, len(m.fields))
+	for _, name := range m.fields {
+		val := strings.TrimSpace(m.inputs[name].Value())
+		if val == "" {
+			if m.required[name] {
+				return nil, fmt.Errorf("%s is required", name)
+			}
+			continue
+		}
+
+		switch m.propType[name] {
+		case "integer":
+			n, err := strconv.Atoi(val)
+			if err != nil {
+				return nil, fmt.Errorf("%s must be an integer: %w", name, err)
+			}
+			args[name] = n
+		case "number":
+			n, err := strconv.ParseFloat(val, 64)
+			if err != nil {
+				return nil, fmt.Errorf("%s must be a number: %w", name, err)
+			}
+			args[name] = n
+		case "boolean":
+			b, err := strconv.ParseBool(val)
+			if err != nil {
+				return nil, fmt.Errorf("%s must be true or false: %w", name, err)
+			}
+			args[name] = b
+		case "array", "object":
+			var v interface{}
+			if err := json.Unmarshal([]byte(val), &v); err != nil {
+				return nil, fmt.Errorf("%s must be valid JSON: %w", name, err)
+			}
+			args[name] = v
+		default:
+			args[name] = val
+		}
+	}
+	return args, nil
+}
+
+func (m ToolPane) View() string {
+	if m.err != nil {
+		return fmt.Sprintf("Error discovering tools: %v", m.err)
+	}
+
+	right := m.schema.View() + "\n"
+	if m.formMode {
+		for i, name := range m.fields {
+			label := itemStyle.Render(name)
+			if i == m.fieldIdx {
+				label = selectedItemStyle.Render("> " + name)
+			}
+			right += label + "\n" + m.inputs[name].View() + "\n"
+		}
+	} else {
+		right += m.args.View()
+	}
+	if m.invoking {
+		right += "\n" + SubtextStyle.Render("Invoking...")
+	} else if m.result != "" {
+		right += "\n" + m.result
+	}
+
+	return lipgloss.JoinHorizontal(lipgloss.Top, m.list.View(), BaseStyle.Render(right))
+}
@@ -0,0 +1,236 @@
+package tui
+
+import (
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// WinMsg notifies an overlay window's model of a window-manager lifecycle
+// event: WinFocus when it becomes the topmost window and starts receiving
+// key input, WinBlur when another window is pushed above it, and
+// WinRefreshData when the manager wants it to reload whatever it displays.
+type WinMsg int
+
+const (
+	WinFocus WinMsg = iota
+	WinBlur
+	WinRefreshData
+)
+
+// Rect sizes an overlay window; a zero Width or Height means "size to the
+// window's own content" instead of a fixed box.
+type Rect struct {
+	Width, Height int
+}
+
+// Window is one entry in the WindowManager's stack: an overlay tea.Model
+// plus the Rect it should be drawn in.
+type Window struct {
+	Model tea.Model
+	Rect  Rect
+}
+
+// WindowManager is a stack of overlay windows composited over MainModel's
+// base view; only the topmost window is focused and receives key input.
+type WindowManager struct {
+	stack []Window
+}
+
+// Len reports how many windows are currently open.
+func (wm *WindowManager) Len() int { return len(wm.stack) }
+
+// Top returns the focused (topmost) window, if any.
+func (wm *WindowManager) Top() (Window, bool) {
+	if len(wm.stack) == 0 {
+		return Window{}, false
+	}
+	return wm.stack[len(wm.stack)-1], true
+}
+
+// Push opens a new overlay on top of the stack, blurring whatever was
+// previously focused and focusing + initializing the new window.
+func (wm *WindowManager) Push(w Window) tea.Cmd {
+	var cmds []tea.Cmd
+	if top, ok := wm.Top(); ok {
+		blurred, cmd := top.Model.Update(WinBlur)
+		wm.stack[len(wm.stack)-1].Model = blurred
+		cmds = append(cmds, cmd)
+	}
+	wm.stack = append(wm.stack, w)
+	cmds = append(cmds, w.Model.Init())
+	focused, cmd := w.Model.Update(WinFocus)
+	wm.stack[len(wm.stack)-1].Model = focused
+	cmds = append(cmds, cmd)
+	return tea.Batch(cmds...)
+}
+
+// Pop closes the topmost overlay and refocuses whatever is now on top, if
+// anything.
+func (wm *WindowManager) Pop() tea.Cmd {
+	if len(wm.stack) == 0 {
+		return nil
+	}
+	wm.stack = wm.stack[:len(wm.stack)-1]
+	top, ok := wm.Top()
+	if !ok {
+		return nil
+	}
+	focused, cmd := top.Model.Update(WinFocus)
+	wm.stack[len(wm.stack)-1].Model = focused
+	return cmd
+}
+
+// Update forwards msg to the topmost window only, so background panes
+// don't also react to keys meant for a dialog.
+func (wm *WindowManager) Update(msg tea.Msg) tea.Cmd {
+	if len(wm.stack) == 0 {
+		return nil
+	}
+	top := len(wm.stack) - 1
+	updated, cmd := wm.stack[top].Model.Update(msg)
+	wm.stack[top].Model = updated
+	return cmd
+}
+
+// View composites every window in the stack over base, topmost last, by
+// centering each one (via lipgloss.Place) and splicing its non-blank cells
+// over base so the underlying pane still shows through around the dialog.
+func (wm *WindowManager) View(base string, screenW, screenH int) string {
+	out := base
+	for _, w := range wm.stack {
+		box := DialogStyle.Render(w.Model.View())
+		width, height := w.Rect.Width, w.Rect.Height
+		if width == 0 {
+			width = lipgloss.Width(box)
+		}
+		if height == 0 {
+			height = lipgloss.Height(box)
+		}
+		placed := lipgloss.Place(screenW, screenH, lipgloss.Center, lipgloss.Center,
+			lipgloss.NewStyle().Width(width).Height(height).Render(box))
+		out = overlayNonBlank(out, placed)
+	}
+	return out
+}
+
+// overlayNonBlank splices every non-space cell of fg onto bg at the same
+// row/column, leaving bg showing through wherever fg is blank. lipgloss
+// has no notion of transparency, so this is the simple line-splicing
+// stand-in for real compositing.
+func overlayNonBlank(bg, fg string) string {
+	bgLines := strings.Split(bg, "\n")
+	fgLines := strings.Split(fg, "\n")
+
+	for i, fgLine := range fgLines {
+		if i >= len(bgLines) {
+			bgLines = append(bgLines, fgLine)
+			continue
+		}
+		bgLines[i] = mergeNonBlank(bgLines[i], fgLine)
+	}
+	return strings.Join(bgLines, "\n")
+}
+
+func mergeNonBlank(bg, fg string) string {
+	bgRunes := []rune(bg)
+	fgRunes := []rune(fg)
+	for len(bgRunes) < len(fgRunes) {
+		bgRunes = append(bgRunes, ' ')
+	}
+	for i, r := range fgRunes {
+		if r != ' ' {
+			bgRunes[i] = r
+		}
+	}
+	return string(bgRunes)
+}
+
+// openDialogMsg/closeDialogMsg are the typed messages OpenDialog/CloseDialog
+// emit; MainModel.Update handles them by pushing/popping the WindowManager.
+type openDialogMsg struct {
+	model tea.Model
+	rect  Rect
+}
+
+type closeDialogMsg struct{}
+
+// OpenDialog pushes model onto the window stack as a new focused overlay,
+// sized to rect (or to its own content if rect is the zero value).
+func OpenDialog(model tea.Model, rect Rect) tea.Cmd {
+	return func() tea.Msg { return openDialogMsg{model: model, rect: rect} }
+}
+
+// CloseDialog pops the topmost window off the stack.
+func CloseDialog() tea.Cmd {
+	return func() tea.Msg { return closeDialogMsg{} }
+}
+
+// ConfirmDialog is a generic yes/no overlay; onConfirm is the cmd run if the
+// user accepts (e.g. the actual delete mutation), nothing runs on decline.
+type ConfirmDialog struct {
+	prompt    string
+	onConfirm tea.Cmd
+}
+
+// NewConfirmDialog builds a confirm overlay asking prompt, running
+// onConfirm only if the user answers yes.
+func NewConfirmDialog(prompt string, onConfirm tea.Cmd) ConfirmDialog {
+	return ConfirmDialog{prompt: prompt, onConfirm: onConfirm}
+}
+
+func (d ConfirmDialog) Init() tea.Cmd { return nil }
+
+func (d ConfirmDialog) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	key, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return d, nil
+	}
+	switch key.String() {
+	case "y", "enter":
+		return d, tea.Batch(d.onConfirm, CloseDialog())
+	case "n", "esc":
+		return d, CloseDialog()
+	}
+	return d, nil
+}
+
+func (d ConfirmDialog) View() string {
+	return d.prompt + "\n\n" + SubtextStyle.Render("[y] yes  [n] no")
+}
+
+// ToastWindow is a transient overlay that shows a message and auto-closes
+// after a short delay, used for mutation failures that would otherwise
+// only be visible in a pane's own (easy to miss) error line.
+type ToastWindow struct {
+	text string
+}
+
+type toastTickMsg struct{}
+
+// NewToastWindow builds a toast overlay showing text.
+func NewToastWindow(text string) ToastWindow {
+	return ToastWindow{text: text}
+}
+
+func (t ToastWindow) Init() tea.Cmd {
+	return tea.Tick(3*time.Second, func(time.Time) tea.Msg { return toastTickMsg{} })
+}
+
+func (t ToastWindow) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if _, ok := msg.(toastTickMsg); ok {
+		return t, CloseDialog()
+	}
+	return t, nil
+}
+
+func (t ToastWindow) View() string {
+	return ToastStyle.Render(t.text)
+}
+
+// ShowToast opens a transient toast window displaying text.
+func ShowToast(text string) tea.Cmd {
+	return OpenDialog(NewToastWindow(text), Rect{})
+}
@@ -0,0 +1,100 @@
+// Package agents defines the Gobby agent abstraction: a named persona with a
+// system prompt and a restricted view of the tools the daemon exposes.
+package agents
+
+import (
+	"context"
+	"fmt"
+
+	"gobby-tui/internal/client"
+)
+
+// Agent is a named persona that restricts which MCP tools are advertised to
+// it. An empty AllowedTools means the agent may see every tool the daemon
+// discovers.
+type Agent struct {
+	Name         string
+	SystemPrompt string
+	AllowedTools []string
+}
+
+// NewAgent creates an Agent with no tool restriction.
+func NewAgent(name, systemPrompt string) Agent {
+	return Agent{Name: name, SystemPrompt: systemPrompt}
+}
+
+// Allows reports whether the agent is permitted to call the given tool.
+func (a Agent) Allows(toolName string) bool {
+	if len(a.AllowedTools) == 0 {
+		return true
+	}
+	for _, t := range a.AllowedTools {
+		if t == toolName {
+			return true
+		}
+	}
+	return false
+}
+
+// Toolbox discovers tools from the daemon and filters them per-agent. It
+// replaces the previous pattern of hard-coded CallTool("server", "tool", ...)
+// call sites with a schema-driven lookup.
+type Toolbox struct {
+	client *client.GobbyClient
+	tools  []client.ToolInfo
+}
+
+// NewToolbox creates an empty Toolbox bound to the given client. Call
+// Discover to populate it.
+func NewToolbox(c *client.GobbyClient) *Toolbox {
+	return &Toolbox{client: c}
+}
+
+// Discover fetches the current tool list from the daemon and caches it.
+func (tb *Toolbox) Discover(ctx context.Context) error {
+	tools, err := tb.client.ListTools(ctx)
+	if err != nil {
+		return fmt.Errorf("discover tools: %w", err)
+	}
+	tb.tools = tools
+	return nil
+}
+
+// Tools returns every discovered tool, unfiltered.
+func (tb *Toolbox) Tools() []client.ToolInfo {
+	return tb.tools
+}
+
+// ToolsFor returns the subset of discovered tools the given agent may use.
+func (tb *Toolbox) ToolsFor(a Agent) []client.ToolInfo {
+	if len(a.AllowedTools) == 0 {
+		return tb.tools
+	}
+	var allowed []client.ToolInfo
+	for _, t := range tb.tools {
+		if a.Allows(t.Name) {
+			allowed = append(allowed, t)
+		}
+	}
+	return allowed
+}
+
+// Find returns the discovered tool with the given name, if any.
+func (tb *Toolbox) Find(name string) (client.ToolInfo, bool) {
+	for _, t := range tb.tools {
+		if t.Name == name {
+			return t, true
+		}
+	}
+	return client.ToolInfo{}, false
+}
+
+// Invoke calls a discovered tool by name with the given arguments, routing
+// through the underlying client's generic CallTool.
+func (tb *Toolbox) Invoke(ctx context.Context, name string, args map[string]interface{}) (map[string]interface{}, error) {
+	t, ok := tb.Find(name)
+	if !ok {
+		return nil, fmt.Errorf("unknown tool: %s", name)
+	}
+	return tb.client.CallTool(ctx, t.Server, t.Name, args)
+}